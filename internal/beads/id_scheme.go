@@ -0,0 +1,125 @@
+package beads
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// IDScheme builds and parses polecat agent bead IDs. Towns with an existing
+// beads naming convention configure a template scheme (see NewIDScheme);
+// everything else uses DefaultIDScheme.
+type IDScheme interface {
+	// PolecatID returns the agent bead ID for polecat name in rig.
+	PolecatID(rig, name string) string
+
+	// ParsePolecatID is the inverse of PolecatID. ok is false if id is not a
+	// polecat agent bead ID under this scheme.
+	ParsePolecatID(id string) (rig, name string, ok bool)
+}
+
+// DefaultIDScheme is the built-in scheme: "<prefix>-<rig>-polecat-<name>"
+// (e.g., "gt-gastown-polecat-Toast"). An empty Prefix means "gt".
+type DefaultIDScheme struct {
+	Prefix string
+}
+
+func (s DefaultIDScheme) prefix() string {
+	if s.Prefix == "" {
+		return "gt"
+	}
+	return s.Prefix
+}
+
+// PolecatID implements IDScheme.
+func (s DefaultIDScheme) PolecatID(rig, name string) string {
+	return PolecatBeadIDWithPrefix(s.prefix(), rig, name)
+}
+
+// ParsePolecatID implements IDScheme.
+func (s DefaultIDScheme) ParsePolecatID(id string) (rig, name string, ok bool) {
+	if !strings.HasPrefix(id, s.prefix()+"-") {
+		return "", "", false
+	}
+	rig, role, name, ok := ParseAgentBeadID(id)
+	if !ok || role != "polecat" || rig == "" || name == "" {
+		return "", "", false
+	}
+	return rig, name, true
+}
+
+// TemplateIDScheme builds IDs from a template containing the placeholders
+// {rig} and {name}, and optionally {prefix} (the rig's beads prefix), e.g.
+// "{prefix}-pc-{rig}-{name}".
+type TemplateIDScheme struct {
+	template string
+	prefix   string
+	re       *regexp.Regexp
+}
+
+var idSchemePlaceholder = regexp.MustCompile(`\{[a-z]+\}`)
+
+// NewIDScheme returns the scheme for a town's bead_id_scheme setting and a
+// rig's beads prefix. An empty template selects DefaultIDScheme.
+func NewIDScheme(template, prefix string) (IDScheme, error) {
+	if template == "" {
+		return DefaultIDScheme{Prefix: prefix}, nil
+	}
+
+	var pattern strings.Builder
+	pattern.WriteString("^")
+	seen := make(map[string]bool)
+	last := 0
+	lastWasField := false
+	for _, loc := range idSchemePlaceholder.FindAllStringIndex(template, -1) {
+		literal := template[last:loc[0]]
+		placeholder := template[loc[0]:loc[1]]
+		last = loc[1]
+
+		if seen[placeholder] {
+			return nil, fmt.Errorf("bead ID scheme %q: %s appears more than once", template, placeholder)
+		}
+		seen[placeholder] = true
+
+		pattern.WriteString(regexp.QuoteMeta(literal))
+		switch placeholder {
+		case "{prefix}":
+			pattern.WriteString(regexp.QuoteMeta(prefix))
+			lastWasField = false
+			continue
+		case "{rig}", "{name}":
+			// Adjacent fields can't be told apart when parsing
+			if lastWasField && literal == "" {
+				return nil, fmt.Errorf("bead ID scheme %q: {rig} and {name} must be separated", template)
+			}
+			pattern.WriteString("(?P<" + strings.Trim(placeholder, "{}") + ">.+?)")
+			lastWasField = true
+		default:
+			return nil, fmt.Errorf("bead ID scheme %q: unknown placeholder %s", template, placeholder)
+		}
+	}
+	pattern.WriteString(regexp.QuoteMeta(template[last:]) + "$")
+
+	if !seen["{rig}"] || !seen["{name}"] {
+		return nil, fmt.Errorf("bead ID scheme %q: must contain {rig} and {name}", template)
+	}
+	return &TemplateIDScheme{
+		template: template,
+		prefix:   prefix,
+		re:       regexp.MustCompile(pattern.String()),
+	}, nil
+}
+
+// PolecatID implements IDScheme.
+func (s *TemplateIDScheme) PolecatID(rig, name string) string {
+	return strings.NewReplacer("{prefix}", s.prefix, "{rig}", rig, "{name}", name).Replace(s.template)
+}
+
+// ParsePolecatID implements IDScheme.
+func (s *TemplateIDScheme) ParsePolecatID(id string) (rig, name string, ok bool) {
+	m := s.re.FindStringSubmatch(id)
+	if m == nil {
+		return "", "", false
+	}
+	return m[s.re.SubexpIndex("rig")], m[s.re.SubexpIndex("name")], true
+}
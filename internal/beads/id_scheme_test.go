@@ -0,0 +1,84 @@
+package beads
+
+import "testing"
+
+func TestDefaultIDSchemeRoundTrip(t *testing.T) {
+	s := DefaultIDScheme{Prefix: "bd"}
+	id := s.PolecatID("beads", "obsidian")
+	if id != "bd-beads-polecat-obsidian" {
+		t.Fatalf("PolecatID = %q, want bd-beads-polecat-obsidian", id)
+	}
+	rig, name, ok := s.ParsePolecatID(id)
+	if !ok || rig != "beads" || name != "obsidian" {
+		t.Errorf("ParsePolecatID(%q) = %q, %q, %v", id, rig, name, ok)
+	}
+
+	for _, id := range []string{
+		"gt-beads-polecat-obsidian", // Other prefix
+		"bd-beads-witness",          // Not a polecat
+		"bd-beads-crew-max",
+		"bd-mayor",
+	} {
+		if _, _, ok := s.ParsePolecatID(id); ok {
+			t.Errorf("ParsePolecatID(%q) ok, want not a polecat ID", id)
+		}
+	}
+
+	if got := (DefaultIDScheme{}).PolecatID("gastown", "Toast"); got != PolecatBeadID("gastown", "Toast") {
+		t.Errorf("empty prefix: PolecatID = %q, want %q", got, PolecatBeadID("gastown", "Toast"))
+	}
+}
+
+func TestTemplateIDSchemeRoundTrip(t *testing.T) {
+	tests := []struct {
+		template string
+		want     string
+	}{
+		{"{prefix}-pc-{rig}-{name}", "acme-pc-gastown-Toast"},
+		{"agent.{rig}.{name}", "agent.gastown.Toast"},
+		{"{name}@{rig}", "Toast@gastown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.template, func(t *testing.T) {
+			s, err := NewIDScheme(tt.template, "acme")
+			if err != nil {
+				t.Fatalf("NewIDScheme: %v", err)
+			}
+			id := s.PolecatID("gastown", "Toast")
+			if id != tt.want {
+				t.Fatalf("PolecatID = %q, want %q", id, tt.want)
+			}
+			rig, name, ok := s.ParsePolecatID(id)
+			if !ok || rig != "gastown" || name != "Toast" {
+				t.Errorf("ParsePolecatID(%q) = %q, %q, %v", id, rig, name, ok)
+			}
+			if _, _, ok := s.ParsePolecatID("gt-gastown-polecat-Toast"); ok {
+				t.Error("default-scheme ID parsed under a template scheme")
+			}
+		})
+	}
+}
+
+func TestNewIDSchemeDefault(t *testing.T) {
+	s, err := NewIDScheme("", "bd")
+	if err != nil {
+		t.Fatalf("NewIDScheme: %v", err)
+	}
+	if got := s.PolecatID("beads", "obsidian"); got != "bd-beads-polecat-obsidian" {
+		t.Errorf("PolecatID = %q, want bd-beads-polecat-obsidian", got)
+	}
+}
+
+func TestNewIDSchemeInvalid(t *testing.T) {
+	for _, template := range []string{
+		"{prefix}-{rig}",       // No {name}
+		"{prefix}-{name}",      // No {rig}
+		"{rig}{name}",          // Fields can't be told apart
+		"{rig}-{name}-{rig}",   // Repeated field
+		"{rig}-{name}-{owner}", // Unknown placeholder
+	} {
+		if _, err := NewIDScheme(template, "gt"); err == nil {
+			t.Errorf("NewIDScheme(%q) succeeded, want error", template)
+		}
+	}
+}
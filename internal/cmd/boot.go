@@ -158,9 +158,9 @@ func runBootStatus(cmd *cobra.Command, args []string) error {
 	} else {
 		if !status.CompletedAt.IsZero() {
 			duration := status.CompletedAt.Sub(status.StartedAt)
-			fmt.Printf("  Completed: %s (%s ago)\n",
+			fmt.Printf("  Completed: %s (%s)\n",
 				status.CompletedAt.Format("15:04:05"),
-				formatDurationAgo(time.Since(status.CompletedAt)))
+				style.HumanizeTime(status.CompletedAt))
 			fmt.Printf("  Duration:  %s\n", duration.Round(time.Millisecond))
 		} else {
 			fmt.Printf("  Started: %s\n", status.StartedAt.Format("15:04:05"))
@@ -315,29 +315,3 @@ func runDegradedTriage(b *boot.Boot) (action, target string, err error) {
 
 	return "nothing", "", nil
 }
-
-// formatDurationAgo formats a duration for human display.
-func formatDurationAgo(d time.Duration) string {
-	switch {
-	case d < time.Minute:
-		return "just now"
-	case d < time.Hour:
-		mins := int(d.Minutes())
-		if mins == 1 {
-			return "1 min"
-		}
-		return fmt.Sprintf("%d min", mins)
-	case d < 24*time.Hour:
-		hours := int(d.Hours())
-		if hours == 1 {
-			return "1 hour"
-		}
-		return fmt.Sprintf("%d hours", hours)
-	default:
-		days := int(d.Hours() / 24)
-		if days == 1 {
-			return "1 day"
-		}
-		return fmt.Sprintf("%d days", days)
-	}
-}
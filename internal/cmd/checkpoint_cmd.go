@@ -168,7 +168,7 @@ func runCheckpointRead(cmd *cobra.Command, args []string) error {
 	}
 
 	fmt.Printf("%s\n\n", style.Bold.Render("Checkpoint"))
-	fmt.Printf("Timestamp: %s (%s ago)\n", cp.Timestamp.Format("2006-01-02 15:04:05"), cp.Age().Round(1))
+	fmt.Printf("Timestamp: %s (%s)\n", cp.Timestamp.Format("2006-01-02 15:04:05"), style.HumanizeTime(cp.Timestamp))
 
 	if cp.MoleculeID != "" {
 		fmt.Printf("Molecule: %s\n", cp.MoleculeID)
@@ -1,13 +1,27 @@
 package cmd
 
 import (
+	"bufio"
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"os"
 	"os/exec"
+	"os/signal"
 	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
 
 	"github.com/spf13/cobra"
-	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/polecat"
@@ -15,15 +29,270 @@ import (
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
 	"github.com/steveyegge/gastown/internal/workspace"
+	"golang.org/x/term"
 )
 
 var (
-	cleanupDryRun       bool
-	cleanupGC           bool
-	cleanupOnlyPolecats bool
-	cleanupOnlyConvoys  bool
+	cleanupDryRun         bool
+	cleanupGC             bool
+	cleanupOnlyPolecats   bool
+	cleanupOnlyConvoys    bool
+	cleanupVerbose        bool
+	cleanupJSON           bool
+	cleanupSuggest        bool
+	cleanupSuggestMatch   string
+	cleanupUndo           bool
+	cleanupSince          string
+	cleanupJobs           int
+	cleanupMaxTargets     int
+	cleanupYes            bool
+	cleanupForce          bool
+	cleanupExport         string
+	cleanupStashDirty     bool
+	cleanupOnlyRigRoot    bool
+	cleanupOnLocked       string
+	cleanupPlan           string
+	cleanupOutputFile     string
+	cleanupShowClean      bool
+	cleanupStrict         bool
+	cleanupDedup          bool
+	cleanupRecomputeTrack bool
+	cleanupSnapshotBefore bool
+	cleanupSnapshotOnly   bool
+	cleanupCloseDups      bool
+	cleanupPolecatTimeout time.Duration
+	cleanupTimeBudget     time.Duration
+	cleanupRigErrorsFatal bool
 )
 
+// cleanupEnvDefaults maps cleanup flags to environment variables that supply
+// their defaults, so scripts and cron jobs don't have to repeat flags.
+// Precedence: explicit flag > environment variable > built-in default.
+// Flags that confirm or direct a single run (--yes, --force, --undo, --plan,
+// ...) are deliberately left out. Keep the list in cleanupCmd's help in sync.
+var cleanupEnvDefaults = []struct{ flag, env string }{
+	{"dry-run", "GT_CLEANUP_DRY_RUN"},
+	{"gc", "GT_CLEANUP_GC"},
+	{"polecats", "GT_CLEANUP_POLECATS"},
+	{"convoys", "GT_CLEANUP_CONVOYS"},
+	{"verbose", "GT_CLEANUP_VERBOSE"},
+	{"json", "GT_CLEANUP_JSON"},
+	{"since", "GT_CLEANUP_SINCE"},
+	{"jobs", "GT_CLEANUP_JOBS"},
+	{"stash-dirty", "GT_CLEANUP_STASH_DIRTY"},
+	{"max-targets", "GT_CLEANUP_MAX_TARGETS"},
+	{"only-rig-root", "GT_CLEANUP_ONLY_RIG_ROOT"},
+	{"on-locked", "GT_CLEANUP_ON_LOCKED"},
+	{"show-clean", "GT_CLEANUP_SHOW_CLEAN"},
+	{"strict", "GT_CLEANUP_STRICT"},
+	{"dedup", "GT_CLEANUP_DEDUP"},
+	{"close-dups", "GT_CLEANUP_CLOSE_DUPS"},
+	{"recompute-tracking", "GT_CLEANUP_RECOMPUTE_TRACKING"},
+	{"polecat-timeout", "GT_CLEANUP_POLECAT_TIMEOUT"},
+	{"time-budget", "GT_CLEANUP_TIME_BUDGET"},
+	{"rig-errors-fatal", "GT_CLEANUP_RIG_ERRORS_FATAL"},
+	{"snapshot-before", "GT_CLEANUP_SNAPSHOT_BEFORE"},
+}
+
+// cleanupOut receives cleanup progress output. It is stdout normally and
+// stderr under --json so the summary on stdout stays machine-readable.
+// During a run it is buffered and flushed at rig boundaries (see
+// flushCleanupOut) so huge sweeps don't stall on per-line terminal writes.
+var cleanupOut io.Writer = os.Stdout
+
+// cleanupOutMu serializes writes to cleanupOut when rigs are processed in
+// parallel (--jobs).
+var cleanupOutMu sync.Mutex
+
+// flushCleanupOut flushes cleanupOut if it is buffered.
+func flushCleanupOut() {
+	if f, ok := cleanupOut.(interface{ Flush() error }); ok {
+		_ = f.Flush()
+	}
+}
+
+// CleanupWarning is a non-fatal problem encountered during a cleanup run.
+type CleanupWarning struct {
+	Rig     string `json:"rig,omitempty"`
+	Message string `json:"message"`
+}
+
+// String renders the warning, prefixed with its rig when known.
+func (cw CleanupWarning) String() string {
+	if cw.Rig == "" {
+		return cw.Message
+	}
+	return fmt.Sprintf("%s: %s", cw.Rig, cw.Message)
+}
+
+// cleanupWarnings collects warnings during a cleanup run so they can be
+// reported together at the end instead of interleaved with progress output.
+// With a custom CleanupSink, each warning is also published as it occurs.
+// Safe for concurrent use by rig workers.
+type cleanupWarnings struct {
+	mu      sync.Mutex
+	items   []CleanupWarning
+	verbose bool // Also echo each warning as it occurs
+
+	// abort, when set (--rig-errors-fatal), cancels the run on the first
+	// rig error; see rigError.
+	abort context.CancelCauseFunc
+}
+
+// errCleanupRigFailed is the cause of a run aborted by --rig-errors-fatal.
+var errCleanupRigFailed = errors.New("rig error with --rig-errors-fatal")
+
+// add records a warning. rig may be empty for town-level warnings.
+func (w *cleanupWarnings) add(rig, format string, args ...interface{}) {
+	cw := CleanupWarning{Rig: rig, Message: fmt.Sprintf(format, args...)}
+	w.mu.Lock()
+	w.items = append(w.items, cw)
+	w.mu.Unlock()
+	if w.verbose || cleanupSink != nil {
+		cleanupOutMu.Lock()
+		emitCleanup(cleanupOut, CleanupEvent{Kind: CleanupEventWarning, Rig: cw.Rig, Message: cw.Message})
+		cleanupOutMu.Unlock()
+	}
+}
+
+// rigError records a failure in one rig, such as listing its polecats or
+// gc'ing its branches. By default it is only a warning; with abort set, the
+// first one also cancels the run so no new work starts.
+func (w *cleanupWarnings) rigError(rig, format string, args ...interface{}) {
+	w.add(rig, format, args...)
+	if w.abort != nil {
+		cw := CleanupWarning{Rig: rig, Message: fmt.Sprintf(format, args...)}
+		w.abort(fmt.Errorf("%w: %s", errCleanupRigFailed, cw))
+	}
+}
+
+// print renders the consolidated warnings section. Prints nothing if empty.
+// Repeated warnings are collapsed (see groupCleanupWarnings) so one root
+// cause, like a locked beads database, reads as one line rather than dozens.
+func (w *cleanupWarnings) print() {
+	if len(w.items) == 0 {
+		return
+	}
+	fmt.Fprintf(cleanupOut, "\n%s\n", style.Warning.Render(fmt.Sprintf("⚠ Warnings (%d):", len(w.items))))
+	for _, g := range groupCleanupWarnings(w.items) {
+		fmt.Fprintf(cleanupOut, "  - %s\n", g)
+	}
+}
+
+// cleanupWarningGroup is a run of warnings with one shared cause.
+type cleanupWarningGroup struct {
+	Message string   // The shared message or underlying error
+	Rigs    []string // Distinct rigs affected, in first-seen order ("" for town-level)
+	Count   int
+}
+
+// String renders the group, e.g. "database is locked (×37 across 3 rigs)".
+func (g cleanupWarningGroup) String() string {
+	msg := CleanupWarning{Message: g.Message}
+	if len(g.Rigs) == 1 {
+		msg.Rig = g.Rigs[0]
+	}
+	if g.Count == 1 {
+		return msg.String()
+	}
+	if len(g.Rigs) > 1 {
+		return fmt.Sprintf("%s (×%d across %d rigs: %s)", msg, g.Count, len(g.Rigs), strings.Join(g.Rigs, ", "))
+	}
+	return fmt.Sprintf("%s (×%d)", msg, g.Count)
+}
+
+// groupCleanupWarnings collapses repeated warnings, in first-seen order.
+// Identical messages are grouped across rigs. Of the rest, those whose
+// underlying error - the text after the last ": ", as in "failed to nuke
+// Toast: database is locked" - repeats are grouped under that error.
+func groupCleanupWarnings(items []CleanupWarning) []cleanupWarningGroup {
+	cause := func(msg string) string {
+		if i := strings.LastIndex(msg, ": "); i >= 0 {
+			return msg[i+2:]
+		}
+		return msg
+	}
+
+	messages := make(map[string]int)
+	for _, cw := range items {
+		messages[cw.Message]++
+	}
+	causes := make(map[string]int)
+	for _, cw := range items {
+		if messages[cw.Message] == 1 {
+			causes[cause(cw.Message)]++
+		}
+	}
+
+	var groups []cleanupWarningGroup
+	index := make(map[string]int) // Group key -> position in groups
+	for _, cw := range items {
+		msg, key := cw.Message, "message:"+cw.Message
+		if messages[cw.Message] == 1 {
+			msg, key = cause(cw.Message), "cause:"+cause(cw.Message)
+			if causes[msg] == 1 {
+				msg, key = cw.Message, "" // Unique: shown as is
+			}
+		}
+		i, ok := index[key]
+		if !ok || key == "" {
+			i = len(groups)
+			groups = append(groups, cleanupWarningGroup{Message: msg})
+			index[key] = i
+		}
+		g := &groups[i]
+		g.Count++
+		if !slices.Contains(g.Rigs, cw.Rig) {
+			g.Rigs = append(g.Rigs, cw.Rig)
+		}
+	}
+	return groups
+}
+
+// CleanupSummary is the result of a cleanup run: the --json output and the
+// payload of the finished event.
+type CleanupSummary struct {
+	DryRun         bool             `json:"dry_run"`
+	PolecatsNuked  int              `json:"polecats_nuked"`
+	BytesReclaimed int64            `json:"bytes_reclaimed"`
+	ConvoysClosed  int              `json:"convoys_closed"`
+	BranchesGCed   int              `json:"branches_gced"`
+	Warnings       []CleanupWarning `json:"warnings"`
+
+	// Rigs is the per-rig polecat breakdown, including rigs with nothing to do.
+	Rigs []CleanupRigSummary `json:"rigs"`
+
+	// Interrupted is set when the run was cancelled (Ctrl-C) before every
+	// phase finished; the totals then cover only the work done.
+	Interrupted bool `json:"interrupted,omitempty"`
+
+	// Aborted is the rig error that stopped the run under --rig-errors-fatal.
+	Aborted string `json:"aborted,omitempty"`
+
+	// BudgetExhausted is set when --time-budget ran out before every phase
+	// finished.
+	BudgetExhausted bool `json:"budget_exhausted,omitempty"`
+
+	// Deferred is the work left for the next run when the run stopped early
+	// (interrupted or out of budget).
+	Deferred *CleanupDeferred `json:"deferred,omitempty"`
+
+	// Duplicates lists convoys found by --dedup.
+	Duplicates []CleanupDuplicateConvoy `json:"duplicate_convoys,omitempty"`
+
+	// TrackingFixes lists convoys whose tracking links --recompute-tracking
+	// corrected.
+	TrackingFixes []CleanupTrackingFix `json:"tracking_fixes,omitempty"`
+
+	// Preflight lists conditions that would make the real run fail. Set only
+	// with --strict.
+	Preflight []CleanupWarning `json:"preflight,omitempty"`
+
+	// Actions lists what a dry run would do. A dry-run summary saved with
+	// --output-file is a plan that --plan can execute.
+	Actions []cleanupAction `json:"actions,omitempty"`
+}
+
 var cleanupCmd = &cobra.Command{
 	Use:     "cleanup",
 	GroupID: GroupWorkspace,
@@ -35,15 +304,140 @@ This is the canonical cleanup command - use it regularly to keep your workspace
 
 Unlike 'gt polecat stale', this command specifically targets polecats in the "done"
 state (zombies with potentially running sessions) and cleans them regardless of
-session state.
+session state. Stale polecats (abandoned without finishing) are left alone.
 
 Examples:
   gt cleanup              # Nuke all done polecats, close completed convoys
   gt cleanup --dry-run    # Preview what would be cleaned up
   gt cleanup --gc         # Also gc stale branches after cleanup
   gt cleanup --polecats   # Only clean polecats (skip convoys)
-  gt cleanup --convoys    # Only close convoys (skip polecats)`,
-	RunE: runCleanup,
+  gt cleanup --convoys    # Only close convoys (skip polecats)
+  gt cleanup --json       # Print a JSON summary (progress goes to stderr)
+  gt cleanup --show-clean --dry-run  # Confirm every rig was checked
+  gt cleanup --undo       # Reopen beads closed by the last run
+  gt cleanup --since 24h --dry-run  # Show which done polecats pass the age gate
+  gt cleanup --jobs 8     # Process up to 8 rigs in parallel
+  gt cleanup --stash-dirty  # Salvage uncommitted work to patches before nuking
+  gt cleanup --convoys --export convoys.json            # Snapshot, then close
+  gt cleanup --convoys --export convoys.json --dry-run  # Snapshot only
+  gt cleanup --convoys --dry-run --suggest-closes --suggest-match 'docs'
+
+--polecats and --convoys each restrict the run to one phase, so they can't
+be combined; pass neither to clean both. --gc follows the polecat phase and
+can't be combined with --convoys.
+
+--dedup reports open convoys whose tracked issues are identical to, or a
+subset of, another open convoy's, with the 'bd close' command to retire
+each. --close-dups closes them, keeping the convoy with the larger set
+(for identical sets, the lowest ID). Closes are undoable with --undo.
+  gt cleanup --convoys --dedup               # Report duplicates
+  gt cleanup --convoys --close-dups --dry-run
+
+--recompute-tracking first re-derives each open convoy's tracked issues
+from the issues its links resolve to in bd, and fixes the links before
+closure is checked: a link to an issue that was moved or renamed is
+re-pointed at its current ID, and a second link to the same issue is
+removed. This unsticks convoys whose stale links never reach "all closed".
+Links bd can't resolve are reported and left alone. It mutates bead
+relationships, so it is opt-in; with --dry-run the fixes are only listed
+(and the closure preview still sees the stale links).
+  gt cleanup --convoys --recompute-tracking --dry-run
+
+In dry-run, --verbose also lists the open issues blocking each convoy
+(title, status, assignee). --suggest-closes prints 'bd close' commands for
+blocking issues assigned to you or matching --suggest-match (a regexp over
+issue ID and title). Nothing is closed automatically.
+
+Each run that changes something is recorded in logs/cleanup.jsonl. Use
+--undo to reopen the convoys and agent beads closed by the last run
+(best-effort; removed polecat worktrees cannot be restored).
+
+--snapshot-before first writes the whole town as it is - every polecat
+with its state, session, and git status, every open convoy with its
+tracked issues, and every branch of each rig - to a timestamped JSON file
+under mayor/snapshots/. If the snapshot can't be written, nothing is
+cleaned. The run's audit record points at the snapshot, so --undo can show
+what the town looked like before. --snapshot-only writes it and exits.
+  gt cleanup --snapshot-before   # Big sweep with an escape hatch
+  gt cleanup --snapshot-only     # Capture the town without cleaning
+
+For change-controlled environments, save a reviewed plan and apply exactly it:
+  gt cleanup --dry-run --json --output-file plan.json   # Review plan.json
+  gt cleanup --plan plan.json                           # Execute it
+--plan skips discovery and acts only on the listed polecats and convoys,
+re-checking each first: a polecat that is no longer done, or a convoy that
+is closed or whose tracked issues changed, is skipped and reported.
+
+With --strict, a dry run is also a preflight: it checks that the real run
+would succeed (bd resolves, the beads databases and polecat directories are
+writable, no worktree is locked by git, polecat sessions can be checked and
+killed) and exits non-zero, listing every problem, if not.
+  gt cleanup --dry-run --strict   # CI gate before a scheduled cleanup
+
+Rigs are cleaned and reported in priority order (the "priority" field in
+mayor/rigs.json, higher first; ties by name).
+
+As a safety net against discovery bugs, cleanup refuses to nuke more than
+--max-targets polecats (default 100), or more than half of all polecats
+(when at least 10 are targeted), unless confirmed with --yes.
+
+A done polecat that is the only polecat assigned to an issue still open in
+an active convoy is kept and reported: its worktree and branch are the
+only work context that issue has left. --force nukes it anyway (--force
+also confirms like --yes).
+
+A worktree that git has locked (index.lock, HEAD.lock, or 'git worktree
+lock') is handled per --on-locked: skip leaves the polecat in place (the
+default), wait retries for up to 30s, and force deletes the lock files and
+nukes anyway - only use it when the locks are known to be stale.
+
+Rigs nested inside another rig's directory are skipped so overlapping
+worktrees aren't processed twice; the outermost rig owns them. Skipped rigs
+are reported. Use --only-rig-root=false to process nested rigs as well.
+
+Ctrl-C interrupts cleanly: the polecat or convoy in progress is finished,
+the rest are skipped, and a partial summary is printed (exit status 130).
+Completed work is still recorded for --undo. Press Ctrl-C again to abort
+immediately.
+
+A single polecat whose session kill or removal hangs (stuck process, NFS)
+is abandoned after --polecat-timeout (default 60s) and reported, so it
+can't stall the whole sweep.
+
+--time-budget bounds the whole run for scheduled windows: once the budget
+is spent, no new polecat, convoy, or phase is started (the one in progress
+is finished), and the summary reports what was completed and what was
+deferred. The next run picks up the deferred work, since cleanup always
+re-discovers its targets.
+  gt cleanup --time-budget 5m   # Cron job on shared infrastructure
+
+By default a rig that fails (its polecats can't be listed, a polecat can't
+be nuked, its branches can't be gc'd) is reported as a warning and the
+other rigs are still cleaned. With --rig-errors-fatal, the first such
+failure stops the run like Ctrl-C would and cleanup exits non-zero with
+that error - a stricter mode for CI.
+
+Warnings are collected during the run and listed together at the end.
+Use --verbose to also see each warning as it happens.
+
+Flag defaults can be set via environment variables, e.g. GT_CLEANUP_DRY_RUN=1
+or GT_CLEANUP_GC=true. These flags have a GT_CLEANUP_<FLAG> variable (dashes
+become underscores):
+  --dry-run --gc --polecats --convoys --verbose --json --since --jobs
+  --stash-dirty --max-targets --only-rig-root --on-locked --show-clean
+  --strict --dedup --close-dups --recompute-tracking --polecat-timeout
+  --time-budget --rig-errors-fatal --snapshot-before
+
+Flags that confirm or direct a single run, such as --yes, --force, --undo,
+and --plan, have none. An explicitly passed flag always wins over the
+environment, which wins over the built-in default.
+
+Below the environment, a town can share defaults via "cleanup_defaults" in
+mayor/rigs.json, e.g. {"cleanup_defaults": {"jobs": "4", "since": "24h"}}.
+Personal overrides go in mayor/rigs.local.json, which is merged over
+rigs.json (its keys win) and is not meant to be committed.`,
+	PreRunE: applyCleanupEnvDefaults,
+	RunE:    runCleanup,
 }
 
 func init() {
@@ -51,268 +445,1275 @@ func init() {
 	cleanupCmd.Flags().BoolVar(&cleanupGC, "gc", false, "Also gc stale branches after cleanup")
 	cleanupCmd.Flags().BoolVar(&cleanupOnlyPolecats, "polecats", false, "Only clean polecats (skip convoys)")
 	cleanupCmd.Flags().BoolVar(&cleanupOnlyConvoys, "convoys", false, "Only close convoys (skip polecats)")
+	cleanupCmd.Flags().BoolVarP(&cleanupVerbose, "verbose", "v", false, "Also print warnings as they occur")
+	cleanupCmd.Flags().BoolVar(&cleanupJSON, "json", false, "Output summary as JSON")
+	cleanupCmd.Flags().BoolVar(&cleanupDedup, "dedup", false, "Report open convoys whose tracked issues duplicate another convoy's")
+	cleanupCmd.Flags().BoolVar(&cleanupCloseDups, "close-dups", false, "Close duplicate convoys, keeping the superset (implies --dedup)")
+	cleanupCmd.Flags().BoolVar(&cleanupRecomputeTrack, "recompute-tracking", false, "Reconcile stale convoy tracking links via bd before checking closure")
+	cleanupCmd.Flags().BoolVar(&cleanupSnapshotBefore, "snapshot-before", false, "Write a snapshot of the town to mayor/snapshots/ before changing anything")
+	cleanupCmd.Flags().BoolVar(&cleanupSnapshotOnly, "snapshot-only", false, "Write the snapshot and exit without cleaning (implies --snapshot-before)")
+	cleanupCmd.Flags().BoolVar(&cleanupStrict, "strict", false, "With --dry-run, fail if the real run would hit errors (preflight)")
+	cleanupCmd.Flags().BoolVar(&cleanupShowClean, "show-clean", false, "Also list rigs with no done polecats")
+	cleanupCmd.Flags().BoolVar(&cleanupSuggest, "suggest-closes", false, "With --dry-run, print bd close commands for issues blocking convoys")
+	cleanupCmd.Flags().IntVarP(&cleanupJobs, "jobs", "j", 1, "Number of rigs to process in parallel")
+	cleanupCmd.Flags().IntVar(&cleanupMaxTargets, "max-targets", 100, "Require --yes when more polecats than this would be nuked (0 disables)")
+	cleanupCmd.Flags().BoolVarP(&cleanupYes, "yes", "y", false, "Confirm an anomalously large cleanup")
+	cleanupCmd.Flags().BoolVar(&cleanupForce, "force", false, "Like --yes, and also nuke polecats that are the only worker on an open convoy issue")
+	cleanupCmd.Flags().BoolVar(&cleanupStashDirty, "stash-dirty", false, "Save uncommitted work as a patch under mayor/salvage/<rig>/ before nuking")
+	cleanupCmd.Flags().BoolVar(&cleanupOnlyRigRoot, "only-rig-root", true, "Skip rigs nested inside another rig's directory")
+	cleanupCmd.Flags().BoolVar(&cleanupRigErrorsFatal, "rig-errors-fatal", false, "Abort the run on the first rig error instead of warning and continuing")
+	cleanupCmd.Flags().DurationVar(&cleanupTimeBudget, "time-budget", 0, "Stop starting new work once the run has taken this long (0 disables)")
+	cleanupCmd.Flags().DurationVar(&cleanupPolecatTimeout, "polecat-timeout", 60*time.Second, "Abandon a polecat whose session kill or removal takes longer than this (0 disables)")
+	cleanupCmd.Flags().StringVar(&cleanupOnLocked, "on-locked", "skip", "When git holds a lock on a worktree: skip, wait (retry), or force (clear locks)")
+	cleanupCmd.Flags().StringVar(&cleanupOutputFile, "output-file", "", "With --json, write the summary to this file instead of stdout")
+	cleanupCmd.Flags().StringVar(&cleanupPlan, "plan", "", "Execute the actions of a plan written by --dry-run --json --output-file")
+	cleanupCmd.Flags().StringVar(&cleanupExport, "export", "", "Write a JSON snapshot of convoys about to be closed to this file")
+	cleanupCmd.Flags().StringVar(&cleanupSince, "since", "", "Only nuke polecats done for at least this long (e.g. 24h, 3d)")
+	cleanupCmd.Flags().BoolVar(&cleanupUndo, "undo", false, "Reopen convoys and agent beads closed by the last cleanup run")
+	cleanupCmd.Flags().StringVar(&cleanupSuggestMatch, "suggest-match", "", "Regexp over issue ID/title selecting issues for --suggest-closes")
 
 	rootCmd.AddCommand(cleanupCmd)
 }
 
+// applyCleanupEnvDefaults fills in flags that weren't passed explicitly from
+// their GT_CLEANUP_* environment variables, then from the town's
+// cleanup_defaults (mayor/rigs.json, overridden by mayor/rigs.local.json).
+func applyCleanupEnvDefaults(cmd *cobra.Command, args []string) error {
+	for _, d := range cleanupEnvDefaults {
+		val, ok := os.LookupEnv(d.env)
+		if !ok || cmd.Flags().Changed(d.flag) {
+			continue
+		}
+		if err := cmd.Flags().Set(d.flag, val); err != nil {
+			return fmt.Errorf("invalid %s=%q: %w", d.env, val, err)
+		}
+	}
+
+	townRoot, err := workspace.FindFromCwd()
+	if err != nil || townRoot == "" {
+		return nil
+	}
+	rigsConfig, err := config.LoadRigsConfig(filepath.Join(townRoot, "mayor", "rigs.json"))
+	if err != nil {
+		return nil // Discovery reports an unreadable registry later
+	}
+	return applyCleanupConfigDefaults(cmd, rigsConfig.CleanupDefaults)
+}
+
+// applyCleanupConfigDefaults fills in flags set neither explicitly nor by
+// environment from the registry's cleanup_defaults. Only flags that have a
+// GT_CLEANUP_* variable can be defaulted this way.
+func applyCleanupConfigDefaults(cmd *cobra.Command, defaults map[string]string) error {
+	names := make([]string, 0, len(defaults))
+	for name := range defaults {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		known := false
+		for _, d := range cleanupEnvDefaults {
+			known = known || d.flag == name
+		}
+		if !known {
+			return fmt.Errorf("invalid cleanup_defaults key %q in mayor/rigs.json: not a defaultable cleanup flag", name)
+		}
+		if cmd.Flags().Changed(name) {
+			continue // Set explicitly or from the environment
+		}
+		if err := cmd.Flags().Set(name, defaults[name]); err != nil {
+			return fmt.Errorf("invalid cleanup_defaults %s=%q in mayor/rigs.json: %w", name, defaults[name], err)
+		}
+	}
+	return nil
+}
+
+// cleanupPhaseSet is which phases a cleanup run performs.
+type cleanupPhaseSet struct {
+	Polecats bool
+	Convoys  bool
+	GC       bool // Branch gc, which follows the polecat phase
+}
+
+// cleanupPhases resolves --polecats, --convoys, and --gc to the phases to
+// run. Neither --polecats nor --convoys means both phases; the two flags
+// contradict each other and are rejected together, as is --gc (a polecat
+// branch phase) with --convoys.
+func cleanupPhases(onlyPolecats, onlyConvoys, gc bool) (cleanupPhaseSet, error) {
+	switch {
+	case onlyPolecats && onlyConvoys:
+		return cleanupPhaseSet{}, fmt.Errorf("--polecats and --convoys cannot be combined; use neither to clean both")
+	case gc && onlyConvoys:
+		return cleanupPhaseSet{}, fmt.Errorf("--gc cleans polecat branches and cannot be combined with --convoys")
+	}
+	return cleanupPhaseSet{
+		Polecats: !onlyConvoys,
+		Convoys:  !onlyPolecats,
+		GC:       gc,
+	}, nil
+}
+
 func runCleanup(cmd *cobra.Command, args []string) error {
-	// Default: clean both polecats and convoys
-	cleanBoth := !cleanupOnlyPolecats && !cleanupOnlyConvoys
+	phases, err := cleanupPhases(cleanupOnlyPolecats, cleanupOnlyConvoys, cleanupGC)
+	if err != nil {
+		return err
+	}
+
+	if cleanupSuggest && !cleanupDryRun {
+		return fmt.Errorf("--suggest-closes requires --dry-run")
+	}
+	if cleanupStrict && !cleanupDryRun {
+		return fmt.Errorf("--strict requires --dry-run")
+	}
+	var suggestRe *regexp.Regexp
+	if cleanupSuggestMatch != "" {
+		if !cleanupSuggest {
+			return fmt.Errorf("--suggest-match requires --suggest-closes")
+		}
+		re, err := regexp.Compile(cleanupSuggestMatch)
+		if err != nil {
+			return fmt.Errorf("invalid --suggest-match: %w", err)
+		}
+		suggestRe = re
+	}
+
+	if cleanupCloseDups {
+		cleanupDedup = true
+	}
+	if cleanupRecomputeTrack && cleanupOnlyPolecats {
+		return fmt.Errorf("--recompute-tracking applies to convoys and cannot be combined with --polecats")
+	}
+	if cleanupDedup && cleanupOnlyPolecats {
+		return fmt.Errorf("--dedup applies to convoys and cannot be combined with --polecats")
+	}
+	if cleanupExport != "" && cleanupOnlyPolecats {
+		return fmt.Errorf("--export applies to convoys and cannot be combined with --polecats")
+	}
+	if cleanupSnapshotOnly {
+		if cleanupJSON {
+			return fmt.Errorf("--snapshot-only cannot be combined with --json")
+		}
+		cleanupSnapshotBefore = true
+	}
+	if cleanupOutputFile != "" && !cleanupJSON {
+		return fmt.Errorf("--output-file requires --json")
+	}
+	if cleanupJobs < 1 {
+		return fmt.Errorf("--jobs must be at least 1")
+	}
+	if cleanupPolecatTimeout < 0 {
+		return fmt.Errorf("--polecat-timeout must not be negative")
+	}
+	if cleanupTimeBudget < 0 {
+		return fmt.Errorf("--time-budget must not be negative")
+	}
+	if _, err := polecat.ParseLockPolicy(cleanupOnLocked); err != nil {
+		return fmt.Errorf("invalid --on-locked: %w", err)
+	}
+
+	var minAge time.Duration
+	if cleanupSince != "" {
+		d, err := parseDuration(cleanupSince)
+		if err != nil {
+			return fmt.Errorf("invalid --since: %w", err)
+		}
+		minAge = d
+	}
 
 	townRoot, err := workspace.FindFromCwdOrError()
 	if err != nil {
 		return fmt.Errorf("not in a Gas Town workspace: %w", err)
 	}
 
-	// Load rigs config
-	rigsConfigPath := filepath.Join(townRoot, "mayor", "rigs.json")
-	rigsConfig, err := config.LoadRigsConfig(rigsConfigPath)
-	if err != nil {
-		rigsConfig = &config.RigsConfig{Rigs: make(map[string]config.RigEntry)}
+	if cleanupUndo {
+		if cleanupOnlyPolecats || cleanupOnlyConvoys || cleanupGC || cleanupJSON || cleanupSnapshotBefore {
+			return fmt.Errorf("--undo cannot be combined with --polecats, --convoys, --gc, --json, or --snapshot-before")
+		}
+		return runCleanupUndo(townRoot, cleanupDryRun)
 	}
 
-	// Discover all rigs
-	g := git.NewGit(townRoot)
-	rigMgr := rig.NewManager(townRoot, rigsConfig, g)
-	rigs, err := rigMgr.DiscoverRigs()
+	if cleanupPlan != "" {
+		if cleanupOnlyPolecats || cleanupOnlyConvoys || cleanupGC || cleanupJSON || cleanupSince != "" || cleanupExport != "" || cleanupStrict || cleanupDedup || cleanupRecomputeTrack || cleanupSnapshotBefore || cleanupTimeBudget > 0 || cleanupRigErrorsFatal {
+			return fmt.Errorf("--plan cannot be combined with --polecats, --convoys, --gc, --json, --since, --export, --strict, --dedup, --recompute-tracking, --snapshot-before, --time-budget, or --rig-errors-fatal")
+		}
+		return runCleanupPlan(townRoot, cleanupPlan, cleanupDryRun)
+	}
+
+	rigs, nested, err := discoverCleanupRigs(townRoot, cleanupOnlyRigRoot)
 	if err != nil {
-		return fmt.Errorf("discovering rigs: %w", err)
+		return err
+	}
+
+	ctx, stop := interruptContext(cmd)
+	defer stop()
+	if cleanupTimeBudget > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cleanupTimeBudget)
+		defer cancel()
+	}
+	ctx, abort := context.WithCancelCause(ctx)
+	defer abort(nil)
+
+	var progress io.Writer = os.Stdout
+	if cleanupSink != nil {
+		progress = io.Discard
+	} else if cleanupJSON {
+		progress = os.Stderr
+	}
+	cleanupOut = bufio.NewWriter(progress)
+	defer func() {
+		flushCleanupOut()
+		cleanupOut = os.Stdout
+	}()
+	warnings := &cleanupWarnings{verbose: cleanupVerbose}
+	if cleanupRigErrorsFatal {
+		warnings.abort = abort
+	}
+
+	// Record what this run changes so --undo can reverse it. In dry-run the
+	// entry collects the planned actions instead (the --plan format).
+	audit := &cleanupAuditEntry{Timestamp: time.Now().UTC(), Actor: detectSender()}
+
+	if cleanupSnapshotBefore {
+		path, err := writeCleanupSnapshot(townRoot, takeCleanupSnapshot(ctx, townRoot, rigs))
+		if err != nil {
+			return fmt.Errorf("pre-run snapshot failed, nothing was cleaned: %w", err)
+		}
+		if cleanupSnapshotOnly {
+			fmt.Fprintf(cleanupOut, "%s Town snapshot written to %s\n", style.SuccessPrefix, path)
+			return nil
+		}
+		fmt.Fprintf(cleanupOut, "%s Pre-run snapshot written to %s\n\n", style.Dim.Render("📸"), path)
+		audit.Snapshot = path
 	}
 
 	if cleanupDryRun {
-		fmt.Printf("%s Cleanup preview (--dry-run)\n\n", style.Bold.Render("🧹"))
+		fmt.Fprintf(cleanupOut, "%s Cleanup preview (--dry-run)\n\n", style.Bold.Render("🧹"))
 	} else {
-		fmt.Printf("%s Gas Town cleanup\n\n", style.Bold.Render("🧹"))
+		fmt.Fprintf(cleanupOut, "%s Gas Town cleanup\n\n", style.Bold.Render("🧹"))
 	}
 
+	printSkippedNestedRigs(nested)
+
 	var totalPolecatsNuked int
+	var totalBytesReclaimed int64
+	var rigBreakdown []CleanupRigSummary
 	var totalConvoysClosed int
 	var totalBranchesGCed int
+	var duplicateConvoys []CleanupDuplicateConvoy
+	var trackingFixes []CleanupTrackingFix
+	deferred := &CleanupDeferred{}
 
 	// Clean polecats
-	if cleanBoth || cleanupOnlyPolecats {
-		nuked, err := cleanupDonePolecats(rigs, cleanupDryRun)
-		if err != nil {
-			style.PrintWarning("polecat cleanup had errors: %v", err)
+	if phases.Polecats {
+		result, err := cleanupDonePolecats(ctx, townRoot, rigs, cleanupDryRun, minAge, warnings, audit)
+		if errors.Is(err, errTooManyCleanupTargets) {
+			return err
 		}
-		totalPolecatsNuked = nuked
+		if err != nil && ctx.Err() == nil {
+			warnings.add("", "polecat cleanup had errors: %v", err)
+		}
+		totalPolecatsNuked = result.Nuked
+		totalBytesReclaimed = result.Reclaimed
+		rigBreakdown = result.Rigs
+		deferred.Polecats = result.Deferred
+		deferred.cutShort(ctx, "polecats", err)
 	}
 
 	// Close convoys
-	if cleanBoth || cleanupOnlyConvoys {
+	if phases.Convoys {
 		townBeads := filepath.Join(townRoot, ".beads")
-		closed, err := cleanupCompletedConvoys(townBeads, cleanupDryRun)
-		if err != nil {
-			style.PrintWarning("convoy cleanup had errors: %v", err)
+		if cleanupRecomputeTrack && deferred.started(ctx, "tracking") {
+			fixes, err := recomputeConvoyTracking(ctx, townBeads, cleanupDryRun)
+			if err != nil && ctx.Err() == nil {
+				warnings.add("", "convoy tracking recompute had errors: %v", err)
+			}
+			trackingFixes = fixes
+			deferred.cutShort(ctx, "tracking", err)
 		}
-		totalConvoysClosed = closed
+
+		if deferred.started(ctx, "convoys") {
+			closed, err := cleanupCompletedConvoys(ctx, townBeads, cleanupDryRun, suggestRe, cleanupExport, warnings, audit)
+			if err != nil && ctx.Err() == nil {
+				warnings.add("", "convoy cleanup had errors: %v", err)
+			}
+			totalConvoysClosed = closed
+			deferred.cutShort(ctx, "convoys", err)
+		}
+
+		if cleanupDedup && deferred.started(ctx, "dedup") {
+			dups, err := dedupConvoys(ctx, townBeads, cleanupDryRun, cleanupCloseDups, audit)
+			if err != nil && ctx.Err() == nil {
+				warnings.add("", "convoy dedup had errors: %v", err)
+			}
+			duplicateConvoys = dups
+			deferred.cutShort(ctx, "dedup", err)
+		}
+		flushCleanupOut()
 	}
 
 	// GC branches if requested
-	if cleanupGC && (cleanBoth || cleanupOnlyPolecats) {
-		gcCount, err := cleanupStaleBranches(rigs, cleanupDryRun)
-		if err != nil {
-			style.PrintWarning("branch gc had errors: %v", err)
+	if phases.GC && deferred.started(ctx, "gc") {
+		gcCount, err := cleanupStaleBranches(ctx, rigs, cleanupDryRun, warnings)
+		if err != nil && ctx.Err() == nil {
+			warnings.add("", "branch gc had errors: %v", err)
 		}
 		totalBranchesGCed = gcCount
+		deferred.cutShort(ctx, "gc", err)
 	}
 
-	// Summary
-	fmt.Println()
+	if !cleanupDryRun && len(audit.Actions) > 0 {
+		if err := appendCleanupAudit(townRoot, audit); err != nil {
+			warnings.add("", "could not write audit log: %v", err)
+		}
+	}
+
+	summary := CleanupSummary{
+		DryRun:         cleanupDryRun,
+		PolecatsNuked:  totalPolecatsNuked,
+		BytesReclaimed: totalBytesReclaimed,
+		ConvoysClosed:  totalConvoysClosed,
+		BranchesGCed:   totalBranchesGCed,
+		Warnings:       warnings.items,
+		Rigs:           rigBreakdown,
+		Duplicates:     duplicateConvoys,
+		TrackingFixes:  trackingFixes,
+		Interrupted:    errors.Is(ctx.Err(), context.Canceled),
+	}
+	if cause := context.Cause(ctx); errors.Is(cause, errCleanupRigFailed) {
+		summary.Interrupted = false
+		summary.Aborted = cause.Error()
+	}
+	if ctx.Err() != nil {
+		summary.BudgetExhausted = errors.Is(ctx.Err(), context.DeadlineExceeded)
+		summary.Deferred = deferred
+	}
+	if summary.Warnings == nil {
+		summary.Warnings = []CleanupWarning{}
+	}
+	if summary.Rigs == nil {
+		summary.Rigs = []CleanupRigSummary{}
+	}
 	if cleanupDryRun {
-		fmt.Printf("%s Dry run complete. Would clean:\n", style.Bold.Render("📋"))
+		summary.Actions = audit.Actions
+	}
+	if cleanupStrict {
+		onLocked, _ := polecat.ParseLockPolicy(cleanupOnLocked)
+		summary.Preflight = cleanupPreflight(townRoot, rigs, audit.Actions, onLocked)
+		if summary.Preflight == nil {
+			summary.Preflight = []CleanupWarning{}
+		}
+	}
+	exitErr := func() error {
+		if summary.Aborted != "" {
+			return context.Cause(ctx)
+		}
+		if summary.Interrupted {
+			return NewSilentExit(130)
+		}
+		if len(summary.Preflight) == 0 {
+			return nil
+		}
+		return fmt.Errorf("preflight failed: %s", style.Count(len(summary.Preflight), "problem", "problems"))
+	}
+	emitCleanup(cleanupOut, CleanupEvent{Kind: CleanupEventFinished, Summary: &summary})
+
+	if cleanupJSON {
+		// Finish progress output first, then write the summary as one document
+		flushCleanupOut()
+		data, err := json.MarshalIndent(summary, "", "  ")
+		if err != nil {
+			return fmt.Errorf("encoding summary: %w", err)
+		}
+		data = append(data, '\n')
+		if cleanupOutputFile != "" {
+			if err := os.WriteFile(cleanupOutputFile, data, 0644); err != nil {
+				return fmt.Errorf("writing %s: %w", cleanupOutputFile, err)
+			}
+			fmt.Fprintf(os.Stderr, "Wrote summary to %s\n", cleanupOutputFile)
+			return exitErr()
+		}
+		if _, err := os.Stdout.Write(data); err != nil {
+			return err
+		}
+		return exitErr()
+	}
+
+	// Summary
+	fmt.Fprintln(cleanupOut)
+	if summary.Aborted != "" {
+		fmt.Fprintf(cleanupOut, "%s Cleanup aborted on a rig error (--rig-errors-fatal). In-flight items were finished and the rest skipped; totals are partial:\n",
+			style.Error.Render("✗"))
+	} else if summary.Interrupted {
+		fmt.Fprintf(cleanupOut, "%s Cleanup interrupted. In-flight items were finished and the rest skipped; totals are partial:\n",
+			style.Warning.Render("⚠"))
+	} else if summary.BudgetExhausted {
+		fmt.Fprintf(cleanupOut, "%s Time budget of %s used up. In-flight items were finished and the rest deferred; completed:\n",
+			style.Warning.Render("⏱"), cleanupTimeBudget)
+	} else if cleanupDryRun {
+		fmt.Fprintf(cleanupOut, "%s Dry run complete. Would clean:\n", style.Bold.Render("📋"))
 	} else {
-		fmt.Printf("%s Cleanup complete:\n", style.Bold.Render("✓"))
+		fmt.Fprintf(cleanupOut, "%s Cleanup complete:\n", style.Bold.Render("✓"))
 	}
 
-	if cleanBoth || cleanupOnlyPolecats {
+	if phases.Polecats {
 		if totalPolecatsNuked > 0 {
-			fmt.Printf("  - %d polecat(s) nuked\n", totalPolecatsNuked)
+			if totalBytesReclaimed > 0 {
+				fmt.Fprintf(cleanupOut, "  - %s nuked (%s reclaimed)\n", style.Count(totalPolecatsNuked, "polecat", "polecats"), formatBytes(totalBytesReclaimed))
+			} else {
+				fmt.Fprintf(cleanupOut, "  - %s nuked\n", style.Count(totalPolecatsNuked, "polecat", "polecats"))
+			}
 		} else {
-			fmt.Printf("  - No done polecats found\n")
+			fmt.Fprintf(cleanupOut, "  - No done polecats found\n")
 		}
 	}
 
-	if cleanBoth || cleanupOnlyConvoys {
+	if cleanupRecomputeTrack {
+		if len(trackingFixes) == 0 {
+			fmt.Fprintf(cleanupOut, "  - No stale convoy tracking found\n")
+		} else if cleanupDryRun {
+			fmt.Fprintf(cleanupOut, "  - Tracking would be corrected on %s\n", style.Count(len(trackingFixes), "convoy", "convoys"))
+		} else {
+			fmt.Fprintf(cleanupOut, "  - Tracking corrected on %s\n", style.Count(len(trackingFixes), "convoy", "convoys"))
+		}
+	}
+
+	if phases.Convoys {
 		if totalConvoysClosed > 0 {
-			fmt.Printf("  - %d convoy(s) closed\n", totalConvoysClosed)
+			fmt.Fprintf(cleanupOut, "  - %s closed\n", style.Count(totalConvoysClosed, "convoy", "convoys"))
 		} else {
-			fmt.Printf("  - No completed convoys found\n")
+			fmt.Fprintf(cleanupOut, "  - No completed convoys found\n")
 		}
 	}
 
-	if cleanupGC {
+	if cleanupDedup {
+		if len(duplicateConvoys) == 0 {
+			fmt.Fprintf(cleanupOut, "  - No duplicate convoys found\n")
+		} else if cleanupCloseDups {
+			closedDups := 0
+			for _, d := range duplicateConvoys {
+				if d.Closed || cleanupDryRun {
+					closedDups++
+				}
+			}
+			fmt.Fprintf(cleanupOut, "  - %s closed\n", style.Count(closedDups, "duplicate convoy", "duplicate convoys"))
+		} else {
+			fmt.Fprintf(cleanupOut, "  - %s found (use --close-dups to close)\n", style.Count(len(duplicateConvoys), "duplicate convoy", "duplicate convoys"))
+		}
+	}
+
+	if phases.GC {
 		if totalBranchesGCed > 0 {
-			fmt.Printf("  - %d branch(es) gc'd\n", totalBranchesGCed)
+			fmt.Fprintf(cleanupOut, "  - %s gc'd\n", style.Count(totalBranchesGCed, "branch", "branches"))
 		} else {
-			fmt.Printf("  - No stale branches found\n")
+			fmt.Fprintf(cleanupOut, "  - No stale branches found\n")
 		}
 	}
 
-	return nil
+	if summary.BudgetExhausted || summary.Aborted != "" {
+		printCleanupDeferred(deferred)
+	}
+
+	warnings.print()
+
+	if cleanupStrict {
+		printPreflightProblems(summary.Preflight)
+	}
+	return exitErr()
 }
 
-// cleanupDonePolecats finds and nukes all polecats in "done" state.
-func cleanupDonePolecats(rigs []*rig.Rig, dryRun bool) (int, error) {
-	t := tmux.NewTmux()
-	var totalNuked int
+// interruptContext returns a context that is cancelled on SIGINT or SIGTERM,
+// so long-running commands can stop between items instead of dying midway.
+// Only the first signal is caught; a second one kills the process as usual.
+func interruptContext(cmd *cobra.Command) (context.Context, context.CancelFunc) {
+	parent := cmd.Context()
+	if parent == nil {
+		parent = context.Background()
+	}
+	ctx, stop := signal.NotifyContext(parent, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-ctx.Done()
+		stop()
+	}()
+	return ctx, stop
+}
+
+// discoverCleanupRigs discovers the town's rigs in priority order. With
+// onlyRoot, rigs nested inside another rig are split out and returned
+// separately so callers can report them as skipped. A missing rigs.json
+// means no rigs; an unreadable or malformed one is an error.
+func discoverCleanupRigs(townRoot string, onlyRoot bool) ([]*rig.Rig, []rig.NestedRig, error) {
+	rigsConfigPath := filepath.Join(townRoot, "mayor", "rigs.json")
+	rigsConfig, err := config.LoadRigsConfig(rigsConfigPath)
+	switch {
+	case errors.Is(err, config.ErrNotFound):
+		// No registry yet: a town without rigs is legitimately empty
+		rigsConfig = &config.RigsConfig{Rigs: make(map[string]config.RigEntry)}
+	case err != nil:
+		// A registry that can't be read must not pass for an empty town:
+		// cleanup would silently do nothing and report a clean run
+		return nil, nil, fmt.Errorf("loading rig registry %s: %w", rigsConfigPath, err)
+	}
+
+	rigMgr := rig.NewManager(townRoot, rigsConfig, git.NewGit(townRoot))
+	rigs, nested, err := rigMgr.DiscoverRootRigs()
+	if err != nil {
+		return nil, nil, fmt.Errorf("discovering rigs: %w", err)
+	}
+	if !onlyRoot {
+		rigs, nested = append(rigs, nestedRigs(nested)...), nil
+		rig.SortByPriority(rigs)
+	}
+	return rigs, nested, nil
+}
+
+// printSkippedNestedRigs reports rigs skipped for being nested in another rig.
+func printSkippedNestedRigs(nested []rig.NestedRig) {
+	for _, n := range nested {
+		fmt.Fprintf(cleanupOut, "%s Skipping rig %s: nested inside rig %s\n",
+			style.Dim.Render("○"), n.Rig.Name, n.Parent.Name)
+	}
+	if len(nested) > 0 {
+		fmt.Fprintln(cleanupOut)
+	}
+}
 
+// nestedRigs returns the nested rigs themselves.
+func nestedRigs(nested []rig.NestedRig) []*rig.Rig {
+	rigs := make([]*rig.Rig, 0, len(nested))
+	for _, n := range nested {
+		rigs = append(rigs, n.Rig)
+	}
+	return rigs
+}
+
+// cleanupRigPlan is the set of done polecats selected for nuking in one rig.
+type cleanupRigPlan struct {
+	mgr      *polecat.Manager
+	polecats []*polecat.Polecat // All polecats in the rig
+	total    int                // All polecats in the rig
+	done     []string           // Done polecats that passed the convoy guard and age gate
+
+	// Filled in by the execute phase (or from done in dry-run)
+	nuked     int
+	removed   []string // Names nuked, in plan order, for the audit log
+	reclaimed int64
+	deferred  int   // Done polecats left for the next run by an early stop
+	err       error // Listing the rig's polecats failed
+}
+
+// cleanupPolecatsResult is the outcome of the polecat phase.
+type cleanupPolecatsResult struct {
+	Nuked     int
+	Reclaimed int64
+	Deferred  int                 // Done polecats not reached before the run stopped
+	Rigs      []CleanupRigSummary // Every rig checked, in rig order
+}
+
+// CleanupRigSummary is one rig's line in the per-rig cleanup breakdown.
+type CleanupRigSummary struct {
+	Rig            string `json:"rig"`
+	Polecats       int    `json:"polecats"`        // All polecats in the rig
+	Targets        int    `json:"targets"`         // Done polecats selected for nuking
+	Nuked          int    `json:"nuked"`           // Polecats nuked (would be nuked, in dry-run)
+	BytesReclaimed int64  `json:"bytes_reclaimed"` // Bytes freed by removed worktrees
+	Error          string `json:"error,omitempty"` // Why the rig couldn't be checked
+}
+
+// Clean reports whether the rig had nothing to do.
+func (s CleanupRigSummary) Clean() bool {
+	return s.Error == "" && s.Targets == 0
+}
+
+// cleanupDonePolecats finds and nukes all polecats in "done" state.
+// townRoot locates the convoys the sole-contributor guard checks.
+// When minAge > 0, only polecats that have been done at least that long are
+// nuked (the --since age gate).
+// Targets are collected for every rig before anything is nuked so the
+// mass-cleanup circuit breaker (checkCleanupTargets) sees the full count.
+// Returns the number nuked, the bytes reclaimed from their worktrees, and a
+// breakdown for every rig (including clean ones).
+// Removed polecats and closed agent beads are recorded in audit; in dry-run,
+// the polecats that would be removed are recorded as the plan.
+func cleanupDonePolecats(ctx context.Context, townRoot string, rigs []*rig.Rig, dryRun bool, minAge time.Duration, warnings *cleanupWarnings, audit *cleanupAuditEntry) (cleanupPolecatsResult, error) {
+	plans := make(map[string]*cleanupRigPlan, len(rigs))
 	for _, r := range rigs {
-		g := git.NewGit(r.Path)
-		mgr := polecat.NewManager(r, g)
+		plans[r.Name] = &cleanupRigPlan{}
+	}
 
-		polecats, err := mgr.List()
+	// List every rig's polecats first: the convoy guard needs to know who
+	// else is working on each issue before any rig's targets are chosen
+	forEachRig(rigs, cleanupJobs, func(r *rig.Rig, out io.Writer) {
+		if ctx.Err() != nil {
+			return
+		}
+		plan := plans[r.Name]
+		plan.mgr = polecat.NewManager(r, git.NewGit(r.Path))
+
+		polecats, err := plan.mgr.List()
 		if err != nil {
-			style.PrintWarning("error listing polecats in %s: %v", r.Name, err)
-			continue
+			plan.err = err
+			warnings.rigError(r.Name, "error listing polecats: %v", err)
+			return
 		}
+		plan.polecats = polecats
+		plan.total = len(polecats)
+	})
+	guard := loadCleanupConvoyGuard(ctx, townRoot, rigs, plans, warnings)
 
-		// Find "done" polecats
-		var donePolecats []*polecat.Polecat
-		for _, p := range polecats {
-			if p.State == polecat.StateDone {
-				donePolecats = append(donePolecats, p)
+	// Plan: find done polecats in each rig
+	forEachRig(rigs, cleanupJobs, func(r *rig.Rig, out io.Writer) {
+		plan := plans[r.Name]
+		if ctx.Err() != nil || plan.mgr == nil || plan.err != nil {
+			return
+		}
+
+		doneNames := polecat.SelectNames(plan.polecats, polecat.IsDone)
+
+		if len(doneNames) == 0 {
+			if cleanupShowClean {
+				fmt.Fprintf(out, "%s %s: clean\n", style.Dim.Render("○"), r.Name)
 			}
+			return
 		}
 
-		if len(donePolecats) == 0 {
-			continue
+		emitCleanup(out, CleanupEvent{Kind: CleanupEventRigStarted, Rig: r.Name, Count: len(doneNames)})
+
+		doneNames = guard.filter(out, r.Name, plan.polecats, doneNames, warnings)
+		if minAge > 0 {
+			now := time.Now()
+			var eligible []string
+			for _, name := range doneNames {
+				changedAt := plan.mgr.StateChangedAt(name)
+				ok := polecatAgeGate(changedAt, minAge, now)
+				verdict := style.Success.Render("eligible")
+				if ok {
+					eligible = append(eligible, name)
+				} else {
+					verdict = style.Dim.Render("too young")
+				}
+				fmt.Fprintf(out, "  %s/%s: done %s - %s\n", r.Name, name, describePolecatAge(changedAt, now), verdict)
+			}
+			doneNames = eligible
+		} else if dryRun {
+			for _, name := range doneNames {
+				fmt.Fprintf(out, "  Would nuke: %s/%s\n", r.Name, name)
+			}
 		}
+		plan.done = doneNames
+	})
 
-		fmt.Printf("%s %s: %d done polecat(s)\n", style.Bold.Render("🔍"), r.Name, len(donePolecats))
+	var targets, total int
+	for _, plan := range plans {
+		targets += len(plan.done)
+		total += plan.total
+	}
 
-		for _, p := range donePolecats {
-			if dryRun {
-				fmt.Printf("  Would nuke: %s/%s\n", r.Name, p.Name)
-				totalNuked++
-				continue
+	if err := ctx.Err(); err != nil {
+		for _, plan := range plans {
+			plan.deferred = len(plan.done)
+		}
+		return summarizeCleanupPlans(rigs, plans), err
+	}
+	if err := checkCleanupTargets(targets, total, dryRun); err != nil {
+		return cleanupPolecatsResult{}, err
+	}
+	if dryRun {
+		for _, r := range rigs {
+			plan := plans[r.Name]
+			plan.nuked = len(plan.done)
+			for _, name := range plan.done {
+				audit.recordAction(cleanupAction{Kind: cleanupActionPolecatRemoved, Rig: r.Name, ID: name,
+					State: polecat.StateDone})
 			}
+		}
+		return summarizeCleanupPlans(rigs, plans), nil
+	}
 
-			fmt.Printf("  Nuking %s/%s...", r.Name, p.Name)
+	// Execute: nuke the planned polecats
+	forEachRig(rigs, cleanupJobs, func(r *rig.Rig, out io.Writer) {
+		plan := plans[r.Name]
+		if len(plan.done) == 0 {
+			return
+		}
+		if ctx.Err() != nil {
+			plan.deferred = len(plan.done)
+			return
+		}
 
-			// Kill session if running
-			sessMgr := polecat.NewSessionManager(t, r)
-			running, _ := sessMgr.IsRunning(p.Name)
-			if running {
-				_ = sessMgr.Stop(p.Name, true) // Force kill
+		// A polecat whose session can't be killed in time is abandoned
+		// rather than stalling the rest of the rig
+		t := tmux.NewTmux()
+		var stopped []string
+		for i, name := range plan.done {
+			if ctx.Err() != nil {
+				plan.deferred += len(plan.done) - i
+				break
+			}
+			done, err := stopPolecatSessionWithin(t, r, name, cleanupPolecatTimeout)
+			if !done {
+				reportPolecatTimeout(out, warnings, r.Name, name,
+					fmt.Sprintf("session kill timed out after %s", cleanupPolecatTimeout))
+				continue
+			}
+			if err != nil {
+				warnings.add(r.Name, "kept %s: %v", name, err)
+				continue
 			}
+			stopped = append(stopped, name)
+		}
 
-			// Remove the polecat (force=true since we know it's done)
-			if err := mgr.Remove(p.Name, true); err != nil {
-				fmt.Printf(" %s (%v)\n", style.Error.Render("failed"), err)
+		// force=true since done polecats are going regardless of worktree state
+		onLocked, _ := polecat.ParseLockPolicy(cleanupOnLocked)
+		opts := polecat.RemoveOptions{Force: true, OnLocked: onLocked, Timeout: cleanupPolecatTimeout}
+		if cleanupStashDirty {
+			opts.BeforeRemove = salvageBeforeRemove(townRoot, r, plan.mgr, out)
+		}
+		result, _ := plan.mgr.RemoveAllContext(ctx, stopped, opts)
+		for _, o := range result.Outcomes {
+			if ctx.Err() != nil && errors.Is(o.Err, ctx.Err()) {
+				plan.deferred++ // Skipped by the interrupt or budget, not failed
+				continue
+			}
+			if errors.Is(o.Err, polecat.ErrRemoveTimeout) {
+				reportPolecatTimeout(out, warnings, r.Name, o.Name, o.Err.Error())
+				continue
+			}
+			if len(o.Locks) > 0 {
+				emitCleanup(out, CleanupEvent{Kind: CleanupEventPolecatLocked, Rig: r.Name, ID: o.Name,
+					Message: describeLockHandling(o, onLocked)})
+			}
+			if errors.Is(o.Err, polecat.ErrWorktreeLocked) {
+				warnings.add(r.Name, "kept %s: %v (%s)", o.Name, o.Err, lockNames(o.Locks))
+				continue
+			}
+			if errors.Is(o.Err, errSalvageFailed) {
+				warnings.add(r.Name, "kept %s: %v", o.Name, o.Err)
+				continue
+			}
+			if o.Err != nil {
+				emitCleanup(out, CleanupEvent{Kind: CleanupEventPolecatFailed, Rig: r.Name, ID: o.Name, Message: o.Err.Error()})
+				warnings.rigError(r.Name, "failed to nuke %s: %v", o.Name, o.Err)
 				continue
 			}
 
-			// Close the agent bead via bd command
-			agentBeadID := beads.PolecatBeadID(r.Name, p.Name)
-			closeCmd := exec.Command("bd", "close", agentBeadID, "-r", "Nuked by gt cleanup")
-			closeCmd.Dir = r.Path
-			_ = closeCmd.Run() // Best effort, ignore errors
+			closePolecatAgentBead(r, o.Name, "Nuked by gt cleanup")
+			emitCleanup(out, CleanupEvent{Kind: CleanupEventPolecatNuked, Rig: r.Name, ID: o.Name})
+			plan.removed = append(plan.removed, o.Name)
+			plan.nuked++
+		}
+		plan.reclaimed = result.Reclaimed
+	})
+
+	// Audit in rig order, not in the order parallel rigs happened to finish
+	for _, r := range rigs {
+		for _, name := range plans[r.Name].removed {
+			audit.record(cleanupActionPolecatRemoved, r.Name, name)
+			audit.record(cleanupActionAgentBeadClosed, r.Name, polecat.AgentBeadID(r, name))
+		}
+	}
+
+	return summarizeCleanupPlans(rigs, plans), ctx.Err()
+}
+
+// summarizeCleanupPlans totals the per-rig plans into the phase result.
+func summarizeCleanupPlans(rigs []*rig.Rig, plans map[string]*cleanupRigPlan) cleanupPolecatsResult {
+	var result cleanupPolecatsResult
+	for _, r := range rigs {
+		plan := plans[r.Name]
+		rs := CleanupRigSummary{
+			Rig:            r.Name,
+			Polecats:       plan.total,
+			Targets:        len(plan.done),
+			Nuked:          plan.nuked,
+			BytesReclaimed: plan.reclaimed,
+		}
+		if plan.err != nil {
+			rs.Error = plan.err.Error()
+		}
+		result.Rigs = append(result.Rigs, rs)
+		result.Nuked += plan.nuked
+		result.Reclaimed += plan.reclaimed
+		result.Deferred += plan.deferred
+	}
+	return result
+}
+
+// describeLockHandling says how a polecat with a locked worktree was handled.
+func describeLockHandling(o polecat.RemoveOutcome, policy polecat.LockPolicy) string {
+	locks := lockNames(o.Locks)
+	switch {
+	case errors.Is(o.Err, polecat.ErrWorktreeLocked) && policy == polecat.LockWait:
+		return fmt.Sprintf("%s held; gave up waiting", locks)
+	case errors.Is(o.Err, polecat.ErrWorktreeLocked):
+		return fmt.Sprintf("%s held; skipped", locks)
+	case policy == polecat.LockForce:
+		return fmt.Sprintf("%s cleared by --on-locked=force", locks)
+	default:
+		return fmt.Sprintf("%s released after waiting", locks)
+	}
+}
+
+// lockNames renders lock file paths by base name ("index.lock, locked").
+func lockNames(locks []string) string {
+	names := make([]string, len(locks))
+	for i, l := range locks {
+		names[i] = filepath.Base(l)
+	}
+	return strings.Join(names, ", ")
+}
+
+// errSalvageFailed keeps a polecat whose uncommitted work couldn't be saved.
+var errSalvageFailed = errors.New("salvage failed")
+
+// salvageBeforeRemove returns the RemoveOptions.BeforeRemove hook for
+// --stash-dirty: it saves each polecat's uncommitted work as a patch under
+// mayor/salvage/<rig>/. Running it only for polecats cleared for removal
+// means one kept for a git lock isn't salvaged again on every run. A failed
+// salvage keeps the polecat (errSalvageFailed).
+func salvageBeforeRemove(townRoot string, r *rig.Rig, mgr *polecat.Manager, out io.Writer) func(string) error {
+	return func(name string) error {
+		path, err := mgr.Salvage(townRoot, name)
+		if err != nil {
+			return fmt.Errorf("%w: %v", errSalvageFailed, err)
+		}
+		if path != "" {
+			fmt.Fprintf(out, "  Salvaged uncommitted work from %s/%s to %s\n", r.Name, name, displayPath(path))
+		}
+		return nil
+	}
+}
+
+// errTooManyCleanupTargets aborts a cleanup run tripped by the mass-cleanup
+// circuit breaker without confirmation.
+var errTooManyCleanupTargets = errors.New("refusing to nuke an unexpectedly large number of polecats")
+
+// cleanupTargetsAnomalous reports whether nuking targets of total polecats is
+// suspicious: more than maxTargets, or more than half of a sizeable fleet.
+// A discovery bug that marks everything "done" looks exactly like this.
+func cleanupTargetsAnomalous(targets, total, maxTargets int) bool {
+	if maxTargets > 0 && targets > maxTargets {
+		return true
+	}
+	return targets >= cleanupMinRatioTargets && targets*2 > total
+}
+
+// cleanupMinRatioTargets is the smallest target count the >50% check applies
+// to, so small towns where most polecats are done aren't flagged.
+const cleanupMinRatioTargets = 10
+
+// checkCleanupTargets is the mass-cleanup circuit breaker. When the target
+// count looks anomalous it requires --yes (or an interactive confirmation).
+// In dry-run it only reports.
+func checkCleanupTargets(targets, total int, dryRun bool) error {
+	if !cleanupTargetsAnomalous(targets, total, cleanupMaxTargets) {
+		return nil
+	}
+
+	cleanupOutMu.Lock()
+	fmt.Fprintf(cleanupOut, "\n%s %s\n", style.Error.Render("⚠ ANOMALY:"),
+		style.Bold.Render(fmt.Sprintf("%d of %s selected for nuking", targets, style.Count(total, "polecat", "polecats"))))
+	fmt.Fprintf(cleanupOut, "  This exceeds the safety threshold (--max-targets %d, or more than half of all polecats).\n", cleanupMaxTargets)
+	flushCleanupOut()
+	cleanupOutMu.Unlock()
+
+	if dryRun || cleanupYes || cleanupForce {
+		return nil
+	}
+	if !cleanupJSON && term.IsTerminal(int(os.Stdin.Fd())) && promptYesNo("Nuke them anyway?") {
+		return nil
+	}
+	return fmt.Errorf("%w (%d of %d); re-run with --yes to confirm", errTooManyCleanupTargets, targets, total)
+}
+
+// forEachRig runs fn for every rig using up to jobs concurrent workers.
+// Each rig's output is buffered and written to cleanupOut as one chunk, in
+// rig order, so parallel runs read the same as sequential ones.
+func forEachRig(rigs []*rig.Rig, jobs int, fn func(r *rig.Rig, out io.Writer)) {
+	if jobs < 1 {
+		jobs = 1
+	}
 
-			fmt.Printf(" %s\n", style.Success.Render("done"))
-			totalNuked++
+	bufs := make([]bytes.Buffer, len(rigs))
+	done := make([]chan struct{}, len(rigs))
+	for i := range done {
+		done[i] = make(chan struct{})
+	}
+
+	work := make(chan int)
+	for w := 0; w < jobs && w < len(rigs); w++ {
+		go func() {
+			for i := range work {
+				fn(rigs[i], &bufs[i])
+				close(done[i])
+			}
+		}()
+	}
+	go func() {
+		for i := range rigs {
+			work <- i
 		}
+		close(work)
+	}()
+
+	for i := range rigs {
+		<-done[i]
+		cleanupOutMu.Lock()
+		_, _ = cleanupOut.Write(bufs[i].Bytes())
+		flushCleanupOut()
+		cleanupOutMu.Unlock()
+	}
+}
+
+// polecatAgeGate reports whether a polecat that changed state at changedAt
+// has been in that state for at least minAge. A zero changedAt (unknown age)
+// never passes the gate.
+func polecatAgeGate(changedAt time.Time, minAge time.Duration, now time.Time) bool {
+	return !changedAt.IsZero() && now.Sub(changedAt) >= minAge
+}
+
+// describePolecatAge renders when a polecat's state last changed, as seen by
+// polecatAgeGate ("2 days ago", "age unknown").
+func describePolecatAge(changedAt, now time.Time) string {
+	if changedAt.IsZero() {
+		return "age unknown"
+	}
+	return style.HumanizeTimeAt(changedAt, now)
+}
+
+// reapPolecat runs the per-polecat teardown sequence shared by cleanup and
+// rig archive: kill the session, remove the worktree, close the agent bead.
+// reason is recorded on the closed agent bead.
+func reapPolecat(t *tmux.Tmux, r *rig.Rig, mgr *polecat.Manager, name, reason string) error {
+	if err := stopPolecatSession(t, r, name); err != nil {
+		return err
 	}
 
-	return totalNuked, nil
+	// Remove the polecat (force=true since the caller decided it's going)
+	if err := mgr.Remove(name, true); err != nil {
+		return err
+	}
+
+	closePolecatAgentBead(r, name, reason)
+	return nil
+}
+
+// stopPolecatSession force-kills the polecat's session if it is running.
+// It returns polecat.ErrSessionAmbiguous, and kills nothing, if the session
+// name may belong to another rig's agent; the caller should then leave the
+// polecat alone. Other session errors are ignored (best effort).
+func stopPolecatSession(t *tmux.Tmux, r *rig.Rig, name string) error {
+	sessMgr := polecat.NewSessionManager(t, r)
+	running, err := sessMgr.IsRunning(name)
+	if errors.Is(err, polecat.ErrSessionAmbiguous) {
+		return err
+	}
+	if running {
+		_ = sessMgr.Stop(name, true) // Force kill
+	}
+	return nil
+}
+
+// stopPolecatSessionWithin is stopPolecatSession bounded by timeout (0 means
+// no limit). It reports done=false if the tmux commands had to be killed.
+func stopPolecatSessionWithin(t *tmux.Tmux, r *rig.Rig, name string, timeout time.Duration) (done bool, err error) {
+	if timeout <= 0 {
+		return true, stopPolecatSession(t, r, name)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	err = stopPolecatSession(t.WithContext(ctx), r, name)
+	if ctx.Err() != nil {
+		return false, nil
+	}
+	return true, err
+}
+
+// reportPolecatTimeout reports a polecat abandoned by --polecat-timeout. It
+// is left in place for the next run.
+func reportPolecatTimeout(out io.Writer, warnings *cleanupWarnings, rigName, name, reason string) {
+	emitCleanup(out, CleanupEvent{Kind: CleanupEventPolecatTimedOut, Rig: rigName, ID: name, Message: reason})
+	warnings.add(rigName, "abandoned %s: %s", name, reason)
+}
+
+// closePolecatAgentBead closes the polecat's agent bead (best effort).
+func closePolecatAgentBead(r *rig.Rig, name, reason string) {
+	_ = closeRigBead(r, polecat.AgentBeadID(r, name), reason) // Best effort, ignore errors
+}
+
+// closeRigBead closes a bead in the rig's beads database.
+func closeRigBead(r *rig.Rig, id, reason string) error {
+	closeCmd := exec.Command("bd", "close", id, "-r", reason)
+	closeCmd.Dir = r.Path
+	closeCmd.Env = append(os.Environ(), "BEADS_DIR="+r.BeadsDir())
+	return closeCmd.Run()
 }
 
 // cleanupCompletedConvoys closes convoys where all tracked issues are complete.
-func cleanupCompletedConvoys(townBeads string, dryRun bool) (int, error) {
-	if dryRun {
-		// For dry run, just list what would be closed
-		closed, err := previewCompletedConvoys(townBeads)
+// In dry-run, suggestRe (may be nil) selects blocking issues for --suggest-closes.
+// When exportPath is set, a snapshot of the convoys to close is written there
+// first and exactly those convoys are closed; if the snapshot can't be
+// written, nothing is closed.
+// A complete convoy left open (changed while closing, or bd close failed) is
+// reported to warnings.
+// Closed convoys are recorded in audit; in dry-run, the convoys that would be
+// closed are recorded with their tracked issues as the plan.
+func cleanupCompletedConvoys(ctx context.Context, townBeads string, dryRun bool, suggestRe *regexp.Regexp, exportPath string, warnings *cleanupWarnings, audit *cleanupAuditEntry) (int, error) {
+	var closed, blocked []convoyPreview
+	if dryRun || exportPath != "" {
+		var err error
+		closed, blocked, err = previewCompletedConvoys(ctx, townBeads)
 		if err != nil {
 			return 0, err
 		}
+	}
+
+	if exportPath != "" {
+		if err := exportConvoySnapshot(exportPath, closed, dryRun); err != nil {
+			return 0, err
+		}
+		fmt.Fprintf(cleanupOut, "  Exported %s to %s\n", style.Count(len(closed), "convoy", "convoys"), exportPath)
+	}
+
+	if dryRun {
+		// For dry run, just list what would be closed
 		for _, c := range closed {
-			fmt.Printf("  Would close convoy: %s (%s)\n", c.ID, c.Title)
+			fmt.Fprintf(cleanupOut, "  Would close convoy: %s (%s)\n", c.ID, c.Title)
+			tracked := make([]string, len(c.Tracked))
+			for i, t := range c.Tracked {
+				tracked[i] = t.ID
+			}
+			audit.recordAction(cleanupAction{Kind: cleanupActionConvoyClosed, ID: c.ID, Title: c.Title, Tracked: tracked})
+		}
+		if cleanupVerbose || cleanupSuggest {
+			printBlockedConvoys(blocked, suggestRe, detectSender())
 		}
 		return len(closed), nil
 	}
 
-	// Use existing function from convoy.go
-	closed, err := checkAndCloseCompletedConvoys(townBeads)
-	if err != nil {
-		return 0, err
+	if exportPath != "" {
+		// Close exactly the exported convoys: one that completed after the
+		// snapshot was taken waits for the next run rather than being closed
+		// without a record in the export.
+		var n int
+		for _, c := range closed {
+			if err := ctx.Err(); err != nil {
+				return n, err
+			}
+			if err := closeCompletedConvoy(townBeads, c.ID, c.Title, c.Tracked, "gt cleanup"); err != nil {
+				warnings.add("", "%v", err)
+				continue
+			}
+			emitCleanup(cleanupOut, CleanupEvent{Kind: CleanupEventConvoyClosed, ID: c.ID, Title: c.Title})
+			audit.record(cleanupActionConvoyClosed, "", c.ID)
+			n++
+		}
+		return n, nil
 	}
 
-	for _, c := range closed {
-		fmt.Printf("  Closed convoy: %s (%s)\n", c.ID, c.Title)
+	// Use existing function from convoy.go. On interrupt it returns the
+	// convoys closed so far along with ctx.Err().
+	closedNow, skipped, err := checkAndCloseCompletedConvoys(ctx, townBeads, "gt cleanup")
+	for _, reason := range skipped {
+		warnings.add("", "%v", reason)
+	}
+	for _, c := range closedNow {
+		emitCleanup(cleanupOut, CleanupEvent{Kind: CleanupEventConvoyClosed, ID: c.ID, Title: c.Title})
+		audit.record(cleanupActionConvoyClosed, "", c.ID)
 	}
 
-	return len(closed), nil
+	return len(closedNow), err
 }
 
-// previewCompletedConvoys lists convoys that would be closed (for dry-run).
-// Uses the same logic as checkAndCloseCompletedConvoys but without closing.
-func previewCompletedConvoys(townBeads string) ([]struct{ ID, Title string }, error) {
-	// List all open convoys via bd command
-	listCmd := exec.Command("bd", "list", "--type=convoy", "--status=open", "--json")
+// convoyPreview is an open convoy as seen by the dry-run preview.
+// It doubles as the --export snapshot record for a convoy.
+type convoyPreview struct {
+	ID      string             `json:"id"`
+	Title   string             `json:"title"`
+	Tracked []trackedIssueInfo `json:"tracked"` // All tracked issues
+	Open    []trackedIssueInfo `json:"-"`       // Tracked issues still blocking the convoy
+}
+
+// convoyExport is the --export snapshot of convoys about to be closed.
+type convoyExport struct {
+	ExportedAt time.Time       `json:"exported_at"`
+	DryRun     bool            `json:"dry_run"`
+	Convoys    []convoyPreview `json:"convoys"`
+}
+
+// exportConvoySnapshot writes the to-be-closed convoys and their tracked
+// issues to path as JSON.
+func exportConvoySnapshot(path string, convoys []convoyPreview, dryRun bool) error {
+	snapshot := convoyExport{ExportedAt: time.Now().UTC(), DryRun: dryRun, Convoys: convoys}
+	if snapshot.Convoys == nil {
+		snapshot.Convoys = []convoyPreview{}
+	}
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding convoy snapshot: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("writing convoy snapshot: %w", err)
+	}
+	return nil
+}
+
+// openConvoy is an open convoy as listed by bd.
+type openConvoy struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+}
+
+// listOpenConvoys lists the town's open convoys via bd.
+func listOpenConvoys(ctx context.Context, townBeads string) ([]openConvoy, error) {
+	listCmd := exec.CommandContext(ctx, "bd", "list", "--type=convoy", "--status=open", "--json")
 	listCmd.Dir = townBeads
 	output, err := listCmd.Output()
 	if err != nil {
 		return nil, fmt.Errorf("listing convoys: %w", err)
 	}
 
-	var convoys []struct {
-		ID    string `json:"id"`
-		Title string `json:"title"`
-	}
+	var convoys []openConvoy
 	if err := json.Unmarshal(output, &convoys); err != nil {
 		return nil, fmt.Errorf("parsing convoy list: %w", err)
 	}
+	return convoys, nil
+}
+
+// previewCompletedConvoys lists convoys that would be closed (for dry-run),
+// plus the convoys that are still blocked along with their open issues.
+// Uses the same logic as checkAndCloseCompletedConvoys but without closing.
+func previewCompletedConvoys(ctx context.Context, townBeads string) (completed, blocked []convoyPreview, err error) {
+	convoys, err := listOpenConvoys(ctx, townBeads)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	var completed []struct{ ID, Title string }
 	for _, convoy := range convoys {
+		if err := ctx.Err(); err != nil {
+			return completed, blocked, err
+		}
 		// Check if all tracked issues are closed
 		tracked := getTrackedIssues(townBeads, convoy.ID)
 		if len(tracked) == 0 {
 			continue
 		}
 
-		allClosed := true
+		preview := convoyPreview{ID: convoy.ID, Title: convoy.Title, Tracked: tracked}
 		for _, t := range tracked {
 			if t.Status != "closed" && t.Status != "tombstone" {
-				allClosed = false
-				break
+				preview.Open = append(preview.Open, t)
 			}
 		}
 
-		if allClosed {
-			completed = append(completed, struct{ ID, Title string }{convoy.ID, convoy.Title})
+		if len(preview.Open) == 0 {
+			completed = append(completed, preview)
+		} else {
+			blocked = append(blocked, preview)
 		}
 	}
 
-	return completed, nil
+	return completed, blocked, nil
+}
+
+// printBlockedConvoys lists the open issues holding each convoy open. When
+// --suggest-closes is set, it also prints bd close commands for issues that
+// are assigned to me or match suggestRe.
+func printBlockedConvoys(blocked []convoyPreview, suggestRe *regexp.Regexp, me string) {
+	var suggestions []string
+	for _, c := range blocked {
+		fmt.Fprintf(cleanupOut, "  Blocked convoy: %s (%s) - %s\n", c.ID, c.Title, style.Count(len(c.Open), "open issue", "open issues"))
+		for _, t := range c.Open {
+			assignee := t.Assignee
+			if assignee == "" {
+				assignee = "unassigned"
+			}
+			fmt.Fprintf(cleanupOut, "    %s [%s] %s %s\n", t.ID, t.Status, t.Title, style.Dim.Render("("+assignee+")"))
+			if cleanupSuggest && shouldSuggestClose(t, suggestRe, me) {
+				suggestions = append(suggestions,
+					fmt.Sprintf("bd close %s -r %q", t.ID, "Unblocks convoy "+c.ID))
+			}
+		}
+	}
+
+	if !cleanupSuggest {
+		return
+	}
+	if len(suggestions) == 0 {
+		fmt.Fprintf(cleanupOut, "\n  %s\n", style.Dim.Render("No close suggestions (no blocking issues assigned to you or matching --suggest-match)"))
+		return
+	}
+	fmt.Fprintf(cleanupOut, "\n  Suggested closes (review before running):\n")
+	for _, s := range suggestions {
+		fmt.Fprintf(cleanupOut, "    %s\n", s)
+	}
+}
+
+// shouldSuggestClose reports whether a blocking issue is a close candidate:
+// assigned to (or being worked by) me, or matching suggestRe by ID or title.
+func shouldSuggestClose(t trackedIssueInfo, suggestRe *regexp.Regexp, me string) bool {
+	if me != "" && (t.Assignee == me || t.Worker == me) {
+		return true
+	}
+	return suggestRe != nil && (suggestRe.MatchString(t.ID) || suggestRe.MatchString(t.Title))
 }
 
 // cleanupStaleBranches runs gc on all rigs.
-func cleanupStaleBranches(rigs []*rig.Rig, dryRun bool) (int, error) {
-	var totalDeleted int
+func cleanupStaleBranches(ctx context.Context, rigs []*rig.Rig, dryRun bool, warnings *cleanupWarnings) (int, error) {
+	var totalDeleted atomic.Int64
 
-	for _, r := range rigs {
+	forEachRig(rigs, cleanupJobs, func(r *rig.Rig, out io.Writer) {
+		if ctx.Err() != nil {
+			return
+		}
 		g := git.NewGit(r.Path)
 		mgr := polecat.NewManager(r, g)
 
 		if dryRun {
-			// For dry run, just count what would be deleted
-			// We can't easily preview this, so skip with a note
-			fmt.Printf("  Would gc branches in %s\n", r.Name)
-			continue
+			stale, baseRef, err := mgr.StaleBranches()
+			if err != nil {
+				warnings.rigError(r.Name, "gc preview failed: %v", err)
+				return
+			}
+			for _, branch := range stale {
+				if branch.Merged {
+					fmt.Fprintf(out, "  Would gc branch: %s/%s\n", r.Name, branch.Name)
+					totalDeleted.Add(1)
+				} else {
+					fmt.Fprintf(out, "  Keep (not merged into %s): %s/%s\n", baseRef, r.Name, branch.Name)
+				}
+			}
+			return
 		}
 
-		deleted, err := mgr.CleanupStaleBranches()
-		if err != nil {
-			style.PrintWarning("gc failed in %s: %v", r.Name, err)
-			continue
+		deleted, failed, err := mgr.CleanupStaleBranchesContext(ctx)
+		for _, f := range failed {
+			warnings.add(r.Name, "%v", f)
+		}
+		if err != nil && ctx.Err() == nil {
+			warnings.rigError(r.Name, "gc failed: %v", err)
+			return
 		}
 
 		if deleted > 0 {
-			fmt.Printf("  GC'd %d branch(es) in %s\n", deleted, r.Name)
-			totalDeleted += deleted
+			emitCleanup(out, CleanupEvent{Kind: CleanupEventBranchesGCed, Rig: r.Name, Count: deleted})
+			totalDeleted.Add(int64(deleted))
 		}
-	}
+	})
+
+	return int(totalDeleted.Load()), ctx.Err()
+}
 
-	return totalDeleted, nil
+// formatBytes renders a byte count with a binary unit (e.g. "1.5 MiB").
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
 }
@@ -0,0 +1,223 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// Cleanup action kinds recorded in the audit log.
+const (
+	cleanupActionPolecatRemoved  = "polecat_removed"   // Worktree deleted (unrecoverable)
+	cleanupActionAgentBeadClosed = "agent_bead_closed" // Reopenable via bd
+	cleanupActionConvoyClosed    = "convoy_closed"     // Reopenable via bd
+)
+
+// cleanupAction is one change made by a cleanup run.
+type cleanupAction struct {
+	Kind string `json:"kind"`
+	Rig  string `json:"rig,omitempty"` // Empty for town-level beads (convoys)
+	ID   string `json:"id"`            // Bead ID, or polecat name for polecat_removed
+
+	// Recorded target state, set on planned (dry-run) actions so --plan can
+	// detect drift before acting.
+	State   polecat.State `json:"state,omitempty"`   // Polecat state when planned
+	Title   string        `json:"title,omitempty"`   // Convoy title
+	Tracked []string      `json:"tracked,omitempty"` // Convoy's tracked issues when planned
+}
+
+// Recoverable reports whether --undo can reverse the action.
+func (a cleanupAction) Recoverable() bool {
+	return a.Kind == cleanupActionAgentBeadClosed || a.Kind == cleanupActionConvoyClosed
+}
+
+// cleanupAuditEntry records the actions taken by one cleanup run.
+// record is safe for concurrent use by rig workers.
+type cleanupAuditEntry struct {
+	mu        sync.Mutex
+	Timestamp time.Time       `json:"ts"`
+	Actor     string          `json:"actor"`
+	Undo      bool            `json:"undo,omitempty"`     // Entry records an --undo run
+	Snapshot  string          `json:"snapshot,omitempty"` // Pre-run snapshot written by --snapshot-before
+	Actions   []cleanupAction `json:"actions"`
+
+	// Retry lists the actions an --undo run failed to reverse; the next
+	// --undo retries them instead of reporting the run as already undone.
+	Retry []cleanupAction `json:"retry,omitempty"`
+}
+
+// record adds an action to the entry. Safe to call on a nil entry.
+func (e *cleanupAuditEntry) record(kind, rig, id string) {
+	e.recordAction(cleanupAction{Kind: kind, Rig: rig, ID: id})
+}
+
+// recordAction adds a fully populated action to the entry. Safe to call on a
+// nil entry.
+func (e *cleanupAuditEntry) recordAction(a cleanupAction) {
+	if e == nil {
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.Actions = append(e.Actions, a)
+}
+
+// cleanupAuditPath returns the path to the cleanup audit log.
+func cleanupAuditPath(townRoot string) string {
+	return filepath.Join(townRoot, "logs", "cleanup.jsonl")
+}
+
+// appendCleanupAudit appends an entry to the cleanup audit log.
+func appendCleanupAudit(townRoot string, entry *cleanupAuditEntry) error {
+	path := cleanupAuditPath(townRoot)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating log dir: %w", err)
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("encoding audit entry: %w", err)
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("opening audit log: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("writing audit log: %w", err)
+	}
+	return nil
+}
+
+// lastCleanupAudit returns the most recent audit log entry, or nil if the
+// log is missing or empty.
+func lastCleanupAudit(townRoot string) (*cleanupAuditEntry, error) {
+	f, err := os.Open(cleanupAuditPath(townRoot))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("opening audit log: %w", err)
+	}
+	defer f.Close()
+
+	var last []byte
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		if line := scanner.Bytes(); len(line) > 0 {
+			last = append(last[:0], line...)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading audit log: %w", err)
+	}
+	if last == nil {
+		return nil, nil
+	}
+
+	var entry cleanupAuditEntry
+	if err := json.Unmarshal(last, &entry); err != nil {
+		return nil, fmt.Errorf("parsing last audit entry: %w", err)
+	}
+	return &entry, nil
+}
+
+// runCleanupUndo reopens the beads closed by the last cleanup run.
+// Worktree removals can't be reversed and are reported as unrecoverable.
+// Beads that fail to reopen are recorded, and the next --undo retries them.
+func runCleanupUndo(townRoot string, dryRun bool) error {
+	last, err := lastCleanupAudit(townRoot)
+	if err != nil {
+		return err
+	}
+	if last == nil || (len(last.Actions) == 0 && len(last.Retry) == 0) {
+		fmt.Printf("%s No cleanup runs recorded; nothing to undo.\n", style.Dim.Render("○"))
+		return nil
+	}
+
+	actions := last.Actions
+	if last.Undo {
+		if len(last.Retry) == 0 {
+			fmt.Printf("%s The last cleanup run was already undone.\n", style.Dim.Render("○"))
+			return nil
+		}
+		actions = last.Retry
+		fmt.Printf("%s Retrying the undo from %s by %s (%s left)\n", style.Bold.Render("↩"),
+			last.Timestamp.Local().Format("2006-01-02 15:04:05"), last.Actor,
+			style.Count(len(actions), "bead", "beads"))
+	} else {
+		fmt.Printf("%s Undoing cleanup run from %s by %s\n", style.Bold.Render("↩"),
+			last.Timestamp.Local().Format("2006-01-02 15:04:05"), last.Actor)
+		if last.Snapshot != "" {
+			fmt.Printf("  %s\n", style.Dim.Render("Town before that run: "+last.Snapshot))
+		}
+	}
+	fmt.Println()
+
+	undo := &cleanupAuditEntry{Timestamp: time.Now().UTC(), Actor: detectSender(), Undo: true}
+	var failed, unrecoverable int
+
+	for _, a := range actions {
+		if !a.Recoverable() {
+			fmt.Printf("  %s Unrecoverable: %s/%s worktree was removed\n", style.Error.Render("✗"), a.Rig, a.ID)
+			unrecoverable++
+			continue
+		}
+
+		if dryRun {
+			fmt.Printf("  Would reopen: %s\n", a.ID)
+			continue
+		}
+
+		reopenCmd := exec.Command("bd", "reopen", a.ID, "--reason=Undo gt cleanup")
+		reopenCmd.Dir = cleanupActionDir(townRoot, a)
+		if a.Rig != "" {
+			r := &rig.Rig{Name: a.Rig, Path: reopenCmd.Dir}
+			reopenCmd.Env = append(os.Environ(), "BEADS_DIR="+r.BeadsDir())
+		}
+		if out, err := reopenCmd.CombinedOutput(); err != nil {
+			fmt.Printf("  %s Failed to reopen %s: %v %s\n", style.Error.Render("✗"), a.ID, err, string(out))
+			undo.Retry = append(undo.Retry, a)
+			failed++
+			continue
+		}
+		fmt.Printf("  %s Reopened %s\n", style.Success.Render("✓"), a.ID)
+		undo.record(a.Kind, a.Rig, a.ID)
+	}
+
+	if !dryRun && (len(undo.Actions) > 0 || len(undo.Retry) > 0) {
+		if err := appendCleanupAudit(townRoot, undo); err != nil {
+			style.PrintWarning("could not record undo in audit log: %v", err)
+		}
+	}
+
+	fmt.Println()
+	if unrecoverable > 0 {
+		fmt.Printf("%s %s could not be undone (worktree removal is permanent)\n",
+			style.WarningPrefix, style.Count(unrecoverable, "action", "actions"))
+	}
+	if failed > 0 {
+		return fmt.Errorf("%s could not be reopened; run --undo again to retry", style.Count(failed, "bead", "beads"))
+	}
+	return nil
+}
+
+// cleanupActionDir returns the directory to run bd in for an action's bead.
+func cleanupActionDir(townRoot string, a cleanupAction) string {
+	if a.Rig == "" {
+		return filepath.Join(townRoot, ".beads")
+	}
+	return filepath.Join(townRoot, a.Rig)
+}
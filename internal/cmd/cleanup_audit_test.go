@@ -0,0 +1,147 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCleanupAuditRoundTrip(t *testing.T) {
+	townRoot := t.TempDir()
+
+	last, err := lastCleanupAudit(townRoot)
+	if err != nil {
+		t.Fatalf("lastCleanupAudit on missing log: %v", err)
+	}
+	if last != nil {
+		t.Fatalf("expected nil entry for missing log, got %+v", last)
+	}
+
+	first := &cleanupAuditEntry{Timestamp: time.Now().UTC(), Actor: "overseer"}
+	first.record(cleanupActionConvoyClosed, "", "hq-cv-1")
+	second := &cleanupAuditEntry{Timestamp: time.Now().UTC(), Actor: "overseer"}
+	second.record(cleanupActionPolecatRemoved, "gastown", "Toast")
+	second.record(cleanupActionAgentBeadClosed, "gastown", "gt-gastown-polecat-Toast")
+
+	for _, e := range []*cleanupAuditEntry{first, second} {
+		if err := appendCleanupAudit(townRoot, e); err != nil {
+			t.Fatalf("appendCleanupAudit: %v", err)
+		}
+	}
+
+	last, err = lastCleanupAudit(townRoot)
+	if err != nil {
+		t.Fatalf("lastCleanupAudit: %v", err)
+	}
+	if last == nil || len(last.Actions) != 2 {
+		t.Fatalf("last entry = %+v, want the second run", last)
+	}
+	if last.Actions[0].Recoverable() {
+		t.Error("polecat_removed should be unrecoverable")
+	}
+	if !last.Actions[1].Recoverable() {
+		t.Error("agent_bead_closed should be recoverable")
+	}
+}
+
+func TestCleanupAuditRecordNilEntry(t *testing.T) {
+	var e *cleanupAuditEntry
+	e.record(cleanupActionConvoyClosed, "", "hq-cv-1") // must not panic
+}
+
+func TestLastCleanupAuditMalformed(t *testing.T) {
+	townRoot := t.TempDir()
+	path := cleanupAuditPath(townRoot)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("not json\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := lastCleanupAudit(townRoot); err == nil {
+		t.Error("expected error for malformed audit entry")
+	}
+}
+
+func TestCleanupActionDir(t *testing.T) {
+	townRoot := "/town"
+	if got := cleanupActionDir(townRoot, cleanupAction{Kind: cleanupActionConvoyClosed, ID: "hq-cv-1"}); got != filepath.Join(townRoot, ".beads") {
+		t.Errorf("convoy dir = %q", got)
+	}
+	if got := cleanupActionDir(townRoot, cleanupAction{Kind: cleanupActionAgentBeadClosed, Rig: "gastown", ID: "x"}); got != filepath.Join(townRoot, "gastown") {
+		t.Errorf("agent bead dir = %q", got)
+	}
+}
+
+func TestCleanupUndoRetriesFailedReopens(t *testing.T) {
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	binDir := filepath.Join(townRoot, "bin")
+	if err := os.MkdirAll(binDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	logPath := filepath.Join(townRoot, "bd.log")
+	fixed := filepath.Join(townRoot, "fixed")
+
+	// hq-cv-2 can't be reopened until the "fixed" file exists
+	bdScript := `#!/bin/sh
+if [ "$1" = "reopen" ]; then
+  if [ "$2" = "hq-cv-2" ] && [ ! -f "` + fixed + `" ]; then
+    echo "database is locked"
+    exit 1
+  fi
+  echo "$2" >> "` + logPath + `"
+fi
+exit 0
+`
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(bdScript), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	run := &cleanupAuditEntry{Timestamp: time.Now().UTC(), Actor: "overseer"}
+	run.record(cleanupActionConvoyClosed, "", "hq-cv-1")
+	run.record(cleanupActionConvoyClosed, "", "hq-cv-2")
+	if err := appendCleanupAudit(townRoot, run); err != nil {
+		t.Fatal(err)
+	}
+
+	// Partial failure: hq-cv-1 is reopened, hq-cv-2 is recorded for retry
+	if err := runCleanupUndo(townRoot, false); err == nil {
+		t.Fatal("undo with a failed reopen succeeded, want an error")
+	}
+	last, err := lastCleanupAudit(townRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !last.Undo || len(last.Retry) != 1 || last.Retry[0].ID != "hq-cv-2" {
+		t.Fatalf("after partial undo, last entry = %+v, want hq-cv-2 left to retry", last)
+	}
+
+	// The retry reopens only what failed
+	if err := os.WriteFile(fixed, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := runCleanupUndo(townRoot, false); err != nil {
+		t.Fatalf("retried undo: %v", err)
+	}
+	data, err := os.ReadFile(logPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Fields(string(data)); strings.Join(got, ",") != "hq-cv-1,hq-cv-2" {
+		t.Errorf("reopened %v, want hq-cv-1 then hq-cv-2 once each", got)
+	}
+
+	last, err = lastCleanupAudit(townRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !last.Undo || len(last.Retry) != 0 {
+		t.Errorf("after retry, last entry = %+v, want a complete undo", last)
+	}
+}
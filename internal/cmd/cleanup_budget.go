@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// CleanupDeferred is the work a cleanup run left undone because it stopped
+// early (Ctrl-C or --time-budget). Nothing needs to be carried over: the
+// next run re-discovers its targets and so picks the deferred work up.
+type CleanupDeferred struct {
+	Polecats int      `json:"polecats"`         // Done polecats planned but not nuked
+	Phases   []string `json:"phases,omitempty"` // Phases skipped or cut short
+}
+
+// started reports whether phase may start. Once ctx is done the phase is
+// recorded as deferred instead.
+func (d *CleanupDeferred) started(ctx context.Context, phase string) bool {
+	if ctx.Err() != nil {
+		d.Phases = append(d.Phases, phase)
+		return false
+	}
+	return true
+}
+
+// cutShort records phase as deferred if it returned early because ctx is
+// done, rather than finishing or failing on its own.
+func (d *CleanupDeferred) cutShort(ctx context.Context, phase string, err error) {
+	if err != nil && ctx.Err() != nil {
+		d.Phases = append(d.Phases, phase)
+	}
+}
+
+// printCleanupDeferred renders what a run that ran out of budget left for
+// the next run.
+func printCleanupDeferred(d *CleanupDeferred) {
+	if d.Polecats == 0 && len(d.Phases) == 0 {
+		return
+	}
+	fmt.Fprintf(cleanupOut, "\n%s\n", style.Warning.Render("Deferred to the next run:"))
+	if d.Polecats > 0 {
+		fmt.Fprintf(cleanupOut, "  - %s not yet nuked\n", style.Count(d.Polecats, "done polecat", "done polecats"))
+	}
+	if len(d.Phases) > 0 {
+		fmt.Fprintf(cleanupOut, "  - Phases skipped or cut short: %s\n", strings.Join(d.Phases, ", "))
+	}
+}
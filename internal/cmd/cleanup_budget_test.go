@@ -0,0 +1,30 @@
+package cmd
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCleanupDeferredPhases(t *testing.T) {
+	d := &CleanupDeferred{}
+
+	live := context.Background()
+	if !d.started(live, "polecats") {
+		t.Fatal("started = false with a live context")
+	}
+	d.cutShort(live, "polecats", context.Canceled) // Failed on its own, not stopped
+
+	expired, cancel := context.WithTimeout(context.Background(), -time.Second)
+	defer cancel()
+	d.cutShort(expired, "convoys", expired.Err())
+	d.cutShort(expired, "dedup", nil) // Finished before the budget ran out
+	if d.started(expired, "gc") {
+		t.Error("started = true after the budget ran out")
+	}
+
+	if want := []string{"convoys", "gc"}; !reflect.DeepEqual(d.Phases, want) {
+		t.Errorf("Phases = %v, want %v", d.Phases, want)
+	}
+}
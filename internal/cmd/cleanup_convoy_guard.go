@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// cleanupConvoyGuard keeps cleanup from nuking a done polecat that is the
+// only polecat on an issue still open in an active convoy: the polecat's
+// worktree and branch are the only work context that issue has left. A nil
+// guard protects nothing.
+type cleanupConvoyGuard struct {
+	convoys map[string]string // Open tracked issue -> a convoy tracking it
+	workers map[string]int    // Issue -> polecats assigned to it, across the rigs being cleaned
+}
+
+// newCleanupConvoyGuard indexes the open issues of blocked convoys (see
+// previewCompletedConvoys) and the issues of every polecat.
+func newCleanupConvoyGuard(blocked []convoyPreview, polecats []*polecat.Polecat) *cleanupConvoyGuard {
+	g := &cleanupConvoyGuard{convoys: make(map[string]string), workers: make(map[string]int)}
+	for _, c := range blocked {
+		for _, t := range c.Open {
+			if _, seen := g.convoys[t.ID]; !seen {
+				g.convoys[t.ID] = c.ID
+			}
+		}
+	}
+	for _, p := range polecats {
+		if p.Issue != "" {
+			g.workers[p.Issue]++
+		}
+	}
+	return g
+}
+
+// protects returns the convoy whose open issue p alone is working on, or ""
+// if nuking p orphans nothing.
+func (g *cleanupConvoyGuard) protects(p *polecat.Polecat) string {
+	if g == nil || p.Issue == "" || g.workers[p.Issue] != 1 {
+		return ""
+	}
+	return g.convoys[p.Issue]
+}
+
+// filter returns the names that may still be nuked: names without the done
+// polecats the guard protects, each of which is reported as kept and as a
+// warning.
+func (g *cleanupConvoyGuard) filter(out io.Writer, rigName string, polecats []*polecat.Polecat, names []string, warnings *cleanupWarnings) []string {
+	if g == nil {
+		return names
+	}
+	byName := make(map[string]*polecat.Polecat, len(polecats))
+	for _, p := range polecats {
+		byName[p.Name] = p
+	}
+
+	var nuke []string
+	for _, name := range names {
+		p := byName[name]
+		if p == nil {
+			nuke = append(nuke, name)
+			continue
+		}
+		convoy := g.protects(p)
+		if convoy == "" {
+			nuke = append(nuke, name)
+			continue
+		}
+		fmt.Fprintf(out, "  %s %s/%s: only polecat on %s, still open in convoy %s %s\n", style.Warning.Render("Keep:"),
+			rigName, name, p.Issue, convoy, style.Dim.Render("(use --force to nuke)"))
+		warnings.add(rigName, "kept %s: only polecat on %s, still open in convoy %s (use --force)", name, p.Issue, convoy)
+	}
+	return nuke
+}
+
+// loadCleanupConvoyGuard builds the guard for a polecat cleanup from the
+// town's blocked convoys and the listed polecats. It returns nil under
+// --force or when no rig has done polecats. If the convoys can't be read
+// the run is not blocked: a warning says no polecat was protected.
+func loadCleanupConvoyGuard(ctx context.Context, townRoot string, rigs []*rig.Rig, plans map[string]*cleanupRigPlan, warnings *cleanupWarnings) *cleanupConvoyGuard {
+	if cleanupForce || len(rigs) == 0 || ctx.Err() != nil {
+		return nil
+	}
+	var all []*polecat.Polecat
+	anyDone := false
+	for _, r := range rigs {
+		for _, p := range plans[r.Name].polecats {
+			all = append(all, p)
+			anyDone = anyDone || polecat.IsDone(p)
+		}
+	}
+	if !anyDone {
+		return nil
+	}
+
+	_, blocked, err := previewCompletedConvoys(ctx, filepath.Join(townRoot, ".beads"))
+	if err != nil {
+		if ctx.Err() == nil {
+			warnings.add("", "could not check convoys, done polecats were not protected: %v", err)
+		}
+		return nil
+	}
+	return newCleanupConvoyGuard(blocked, all)
+}
@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/polecat"
+)
+
+func TestCleanupConvoyGuard(t *testing.T) {
+	blocked := []convoyPreview{
+		{ID: "hq-cv-1", Open: []trackedIssueInfo{{ID: "gt-solo"}, {ID: "gt-shared"}}},
+	}
+	polecats := []*polecat.Polecat{
+		{Name: "Toast", State: polecat.StateDone, Issue: "gt-solo"},     // Only worker: kept
+		{Name: "Nux", State: polecat.StateDone, Issue: "gt-shared"},     // Slit also works on it
+		{Name: "Slit", State: polecat.StateWorking, Issue: "gt-shared"}, // Not a target
+		{Name: "Ace", State: polecat.StateDone, Issue: "gt-untracked"},  // Not in an open convoy
+		{Name: "Dag", State: polecat.StateDone},                         // No issue
+	}
+	g := newCleanupConvoyGuard(blocked, polecats)
+
+	var out bytes.Buffer
+	warnings := &cleanupWarnings{}
+	nuke := g.filter(&out, "gastown", polecats, []string{"Ace", "Dag", "Nux", "Toast"}, warnings)
+
+	if want := []string{"Ace", "Dag", "Nux"}; strings.Join(nuke, ",") != strings.Join(want, ",") {
+		t.Errorf("nuke = %v, want %v", nuke, want)
+	}
+	if !strings.Contains(out.String(), "gastown/Toast: only polecat on gt-solo, still open in convoy hq-cv-1") {
+		t.Errorf("output = %q, want Toast reported as kept", out.String())
+	}
+	if len(warnings.items) != 1 || warnings.items[0].Rig != "gastown" {
+		t.Errorf("warnings = %+v, want one for gastown", warnings.items)
+	}
+
+	var nilGuard *cleanupConvoyGuard
+	if got := nilGuard.filter(&out, "gastown", polecats, []string{"Toast"}, warnings); len(got) != 1 {
+		t.Errorf("nil guard returned %v, want Toast passed through", got)
+	}
+}
@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"sort"
+
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// CleanupDuplicateConvoy is an open convoy whose tracked issues are all
+// tracked by another open convoy (the keeper).
+type CleanupDuplicateConvoy struct {
+	ID        string `json:"id"`
+	Title     string `json:"title"`
+	KeepID    string `json:"keep_id"`
+	KeepTitle string `json:"keep_title"`
+	Identical bool   `json:"identical"` // Same issue set as the keeper, rather than a strict subset
+	Closed    bool   `json:"closed"`    // Closed by --close-dups
+}
+
+// findDuplicateConvoys returns the convoys whose tracked-issue sets are
+// identical to or a subset of another convoy's. Each duplicate's keeper is
+// the largest convoy covering it; identical sets keep the lowest ID, so
+// exactly one convoy of every identical group survives and a keeper is
+// never itself a duplicate. Convoys tracking nothing are ignored.
+func findDuplicateConvoys(convoys []convoyPreview) []CleanupDuplicateConvoy {
+	sets := make([]map[string]bool, len(convoys))
+	for i, c := range convoys {
+		sets[i] = make(map[string]bool, len(c.Tracked))
+		for _, t := range c.Tracked {
+			sets[i][t.ID] = true
+		}
+	}
+
+	// covers reports whether convoy j makes convoy i redundant
+	covers := func(j, i int) bool {
+		if i == j || len(sets[i]) == 0 || len(sets[j]) < len(sets[i]) {
+			return false
+		}
+		for id := range sets[i] {
+			if !sets[j][id] {
+				return false
+			}
+		}
+		return len(sets[j]) > len(sets[i]) || convoys[j].ID < convoys[i].ID
+	}
+
+	var dups []CleanupDuplicateConvoy
+	for i, c := range convoys {
+		keep := -1
+		for j := range convoys {
+			if !covers(j, i) {
+				continue
+			}
+			if keep < 0 || len(sets[j]) > len(sets[keep]) ||
+				(len(sets[j]) == len(sets[keep]) && convoys[j].ID < convoys[keep].ID) {
+				keep = j
+			}
+		}
+		if keep < 0 {
+			continue
+		}
+		dups = append(dups, CleanupDuplicateConvoy{
+			ID:        c.ID,
+			Title:     c.Title,
+			KeepID:    convoys[keep].ID,
+			KeepTitle: convoys[keep].Title,
+			Identical: len(sets[keep]) == len(sets[i]),
+		})
+	}
+	sort.Slice(dups, func(a, b int) bool { return dups[a].ID < dups[b].ID })
+	return dups
+}
+
+// dedupConvoys reports open convoys that duplicate another convoy's tracked
+// issues (--dedup) and, with closeDups, closes them, keeping the superset.
+// Convoys about to be closed as completed are not considered. Closes are
+// recorded in audit so --undo can reopen them.
+func dedupConvoys(ctx context.Context, townBeads string, dryRun, closeDups bool, audit *cleanupAuditEntry) ([]CleanupDuplicateConvoy, error) {
+	_, open, err := previewCompletedConvoys(ctx, townBeads)
+	if err != nil {
+		return nil, err
+	}
+
+	dups := findDuplicateConvoys(open)
+	var failed int
+	for i := range dups {
+		if err := ctx.Err(); err != nil {
+			return dups[:i], err
+		}
+		d := &dups[i]
+		relation := "a subset of"
+		if d.Identical {
+			relation = "identical to"
+		}
+		fmt.Fprintf(cleanupOut, "  Duplicate convoy: %s (%s) - tracked issues are %s %s (%s)\n",
+			d.ID, d.Title, relation, d.KeepID, d.KeepTitle)
+
+		reason := "Duplicate of convoy " + d.KeepID
+		switch {
+		case !closeDups:
+			fmt.Fprintf(cleanupOut, "    %s\n", style.Dim.Render(fmt.Sprintf("bd close %s -r %q", d.ID, reason)))
+		case dryRun:
+			fmt.Fprintf(cleanupOut, "    Would close %s\n", d.ID)
+		default:
+			closeCmd := exec.Command("bd", "close", d.ID, "-r", convoyCloseReason(reason, "gt cleanup"))
+			closeCmd.Dir = townBeads
+			if out, err := closeCmd.CombinedOutput(); err != nil {
+				fmt.Fprintf(cleanupOut, "    %s Failed to close %s: %v %s\n", style.Error.Render("✗"), d.ID, err, string(out))
+				failed++
+				continue
+			}
+			d.Closed = true
+			audit.record(cleanupActionConvoyClosed, "", d.ID)
+			emitCleanup(cleanupOut, CleanupEvent{Kind: CleanupEventConvoyClosed, ID: d.ID, Title: d.Title, Message: reason})
+		}
+	}
+	if failed > 0 {
+		return dups, fmt.Errorf("%s failed to close", style.Count(failed, "duplicate convoy", "duplicate convoys"))
+	}
+	return dups, nil
+}
@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"testing"
+)
+
+func dedupFixture(id string, tracked ...string) convoyPreview {
+	c := convoyPreview{ID: id, Title: id + " title"}
+	for _, t := range tracked {
+		c.Tracked = append(c.Tracked, trackedIssueInfo{ID: t})
+	}
+	return c
+}
+
+func TestFindDuplicateConvoys(t *testing.T) {
+	convoys := []convoyPreview{
+		dedupFixture("hq-cv-d", "a", "b", "c"),
+		dedupFixture("hq-cv-b", "a", "b"),      // Subset of d
+		dedupFixture("hq-cv-c", "a", "b"),      // Identical to b, also a subset of d
+		dedupFixture("hq-cv-a", "x", "y"),      // Identical to e; lower ID wins
+		dedupFixture("hq-cv-e", "y", "x"),      // Duplicate of a
+		dedupFixture("hq-cv-f", "x", "z"),      // Overlaps a but isn't covered
+		dedupFixture("hq-cv-g"),                // Tracks nothing: ignored
+		dedupFixture("hq-cv-h", "a", "b", "q"), // Overlaps d but isn't covered
+	}
+
+	dups := findDuplicateConvoys(convoys)
+	want := []struct {
+		id, keep  string
+		identical bool
+	}{
+		{"hq-cv-b", "hq-cv-d", false},
+		{"hq-cv-c", "hq-cv-d", false},
+		{"hq-cv-e", "hq-cv-a", true},
+	}
+	if len(dups) != len(want) {
+		t.Fatalf("dups = %+v, want %d", dups, len(want))
+	}
+	for i, w := range want {
+		d := dups[i]
+		if d.ID != w.id || d.KeepID != w.keep || d.Identical != w.identical {
+			t.Errorf("dup %d = %+v, want %s kept by %s (identical=%v)", i, d, w.id, w.keep, w.identical)
+		}
+		if d.KeepTitle != w.keep+" title" {
+			t.Errorf("dup %d keep title = %q", i, d.KeepTitle)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// CleanupEventKind identifies a cleanup progress event.
+type CleanupEventKind string
+
+// Cleanup progress events, in the order a run typically publishes them.
+const (
+	CleanupEventRigStarted      CleanupEventKind = "rig_started"       // Done polecats found in a rig
+	CleanupEventPolecatNuked    CleanupEventKind = "polecat_nuked"     // Polecat removed
+	CleanupEventPolecatFailed   CleanupEventKind = "polecat_failed"    // Polecat removal failed
+	CleanupEventPolecatLocked   CleanupEventKind = "polecat_locked"    // Worktree locked by git; Message says how it was handled
+	CleanupEventPolecatTimedOut CleanupEventKind = "polecat_timed_out" // Abandoned after --polecat-timeout; Message says which step
+	CleanupEventConvoyClosed    CleanupEventKind = "convoy_closed"     // Completed convoy closed
+	CleanupEventBranchesGCed    CleanupEventKind = "branches_gced"     // Stale branches deleted in a rig
+	CleanupEventWarning         CleanupEventKind = "warning"           // Non-fatal problem
+	CleanupEventFinished        CleanupEventKind = "finished"          // Run complete; carries the summary
+)
+
+// CleanupEvent is one structured progress event from a cleanup run.
+type CleanupEvent struct {
+	Kind    CleanupEventKind `json:"kind"`
+	Rig     string           `json:"rig,omitempty"`
+	ID      string           `json:"id,omitempty"`      // Polecat name or convoy ID
+	Title   string           `json:"title,omitempty"`   // Convoy title
+	Count   int              `json:"count,omitempty"`   // Polecats found (rig_started) or branches deleted (branches_gced)
+	Message string           `json:"message,omitempty"` // Warning text, failure reason, or why a convoy was closed
+	Summary *CleanupSummary  `json:"summary,omitempty"` // Set on finished
+}
+
+// CleanupSink receives cleanup progress events. Calls are serialized, but
+// with --jobs > 1 events from different rigs may interleave.
+type CleanupSink interface {
+	Event(ev CleanupEvent)
+}
+
+var (
+	cleanupSink   CleanupSink // nil selects the text printer
+	cleanupSinkMu sync.Mutex
+)
+
+// SetCleanupSink routes cleanup progress events to sink instead of printing
+// them. While a sink is set, the text progress output is discarded and
+// warnings are published as they occur; the --json summary on stdout is
+// unaffected. Pass nil to restore the default printer.
+func SetCleanupSink(sink CleanupSink) {
+	cleanupSink = sink
+}
+
+// emitCleanup publishes ev to the configured sink. With no sink set, ev is
+// rendered as text to out (a rig's buffer inside forEachRig).
+func emitCleanup(out io.Writer, ev CleanupEvent) {
+	if cleanupSink == nil {
+		textCleanupSink{w: out}.Event(ev)
+		return
+	}
+	cleanupSinkMu.Lock()
+	defer cleanupSinkMu.Unlock()
+	cleanupSink.Event(ev)
+}
+
+// textCleanupSink is the default sink: it renders events as the
+// human-readable progress lines.
+type textCleanupSink struct {
+	w io.Writer
+}
+
+func (s textCleanupSink) Event(ev CleanupEvent) {
+	switch ev.Kind {
+	case CleanupEventRigStarted:
+		fmt.Fprintf(s.w, "%s %s: %s\n", style.Bold.Render("🔍"), ev.Rig, style.Count(ev.Count, "done polecat", "done polecats"))
+	case CleanupEventPolecatNuked:
+		fmt.Fprintf(s.w, "  Nuking %s/%s... %s\n", ev.Rig, ev.ID, style.Success.Render("done"))
+	case CleanupEventPolecatFailed:
+		fmt.Fprintf(s.w, "  Nuking %s/%s... %s\n", ev.Rig, ev.ID, style.Error.Render("failed"))
+	case CleanupEventPolecatTimedOut:
+		fmt.Fprintf(s.w, "  Nuking %s/%s... %s (%s)\n", ev.Rig, ev.ID, style.Warning.Render("timed out"), ev.Message)
+	case CleanupEventPolecatLocked:
+		fmt.Fprintf(s.w, "  Locked %s/%s: %s\n", ev.Rig, ev.ID, ev.Message)
+	case CleanupEventConvoyClosed:
+		fmt.Fprintf(s.w, "  Closed convoy: %s (%s)\n", ev.ID, ev.Title)
+	case CleanupEventBranchesGCed:
+		fmt.Fprintf(s.w, "  GC'd %s in %s\n", style.Count(ev.Count, "branch", "branches"), ev.Rig)
+	case CleanupEventWarning:
+		fmt.Fprintf(s.w, "%s %s\n", style.Warning.Render("⚠ Warning:"), CleanupWarning{Rig: ev.Rig, Message: ev.Message})
+	}
+	// CleanupEventFinished: runCleanup prints the summary itself
+}
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// recordingSink collects published cleanup events.
+type recordingSink struct {
+	events []CleanupEvent
+}
+
+func (s *recordingSink) Event(ev CleanupEvent) {
+	s.events = append(s.events, ev)
+}
+
+func TestEmitCleanupDefaultPrintsText(t *testing.T) {
+	var buf bytes.Buffer
+	emitCleanup(&buf, CleanupEvent{Kind: CleanupEventPolecatNuked, Rig: "gastown", ID: "Toast"})
+	emitCleanup(&buf, CleanupEvent{Kind: CleanupEventConvoyClosed, ID: "hq-cv-1", Title: "Docs"})
+	emitCleanup(&buf, CleanupEvent{Kind: CleanupEventFinished, Summary: &CleanupSummary{}})
+
+	out := buf.String()
+	if !strings.Contains(out, "Nuking gastown/Toast...") {
+		t.Errorf("missing nuke line in %q", out)
+	}
+	if !strings.Contains(out, "Closed convoy: hq-cv-1 (Docs)") {
+		t.Errorf("missing convoy line in %q", out)
+	}
+	if strings.Count(out, "\n") != 2 {
+		t.Errorf("finished event should print nothing, got %q", out)
+	}
+}
+
+func TestEmitCleanupCustomSink(t *testing.T) {
+	sink := &recordingSink{}
+	SetCleanupSink(sink)
+	defer SetCleanupSink(nil)
+
+	var buf bytes.Buffer
+	oldOut := cleanupOut
+	cleanupOut = &buf
+	defer func() { cleanupOut = oldOut }()
+
+	emitCleanup(&buf, CleanupEvent{Kind: CleanupEventRigStarted, Rig: "gastown", Count: 2})
+
+	// Warnings are published even without --verbose when a sink is set
+	w := &cleanupWarnings{}
+	w.add("gastown", "gc failed: %v", "boom")
+
+	if buf.Len() != 0 {
+		t.Errorf("custom sink should bypass the text printer, got %q", buf.String())
+	}
+	if len(sink.events) != 2 {
+		t.Fatalf("events = %d, want 2", len(sink.events))
+	}
+	if ev := sink.events[1]; ev.Kind != CleanupEventWarning || ev.Rig != "gastown" || ev.Message != "gc failed: boom" {
+		t.Errorf("warning event = %+v", ev)
+	}
+}
@@ -0,0 +1,206 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// loadCleanupPlan reads a plan saved by 'gt cleanup --dry-run --json
+// --output-file'.
+func loadCleanupPlan(path string) (*CleanupSummary, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading plan: %w", err)
+	}
+
+	var plan CleanupSummary
+	if err := json.Unmarshal(data, &plan); err != nil {
+		return nil, fmt.Errorf("parsing plan %s: %w", path, err)
+	}
+	if !plan.DryRun {
+		return nil, fmt.Errorf("%s is not a plan: it records a completed run, not a --dry-run", path)
+	}
+	return &plan, nil
+}
+
+// runCleanupPlan executes exactly the actions listed in a reviewed plan,
+// without re-discovering targets. Each target is re-checked against its
+// recorded state first; targets that drifted are skipped and reported.
+// With dryRun, only the checks are run.
+func runCleanupPlan(townRoot, path string, dryRun bool) error {
+	plan, err := loadCleanupPlan(path)
+	if err != nil {
+		return err
+	}
+	if len(plan.Actions) == 0 {
+		fmt.Printf("%s Plan %s has no actions; nothing to do.\n", style.Dim.Render("○"), path)
+		return nil
+	}
+
+	rigs, _, err := discoverCleanupRigs(townRoot, false)
+	if err != nil {
+		return err
+	}
+	rigsByName := make(map[string]*rig.Rig, len(rigs))
+	for _, r := range rigs {
+		rigsByName[r.Name] = r
+	}
+
+	fmt.Printf("%s Applying cleanup plan %s (%s)\n\n", style.Bold.Render("🧹"), path,
+		style.Count(len(plan.Actions), "action", "actions"))
+
+	onLocked, _ := polecat.ParseLockPolicy(cleanupOnLocked)
+	townBeads := filepath.Join(townRoot, ".beads")
+	audit := &cleanupAuditEntry{Timestamp: time.Now().UTC(), Actor: detectSender()}
+	t := tmux.NewTmux()
+	var applied, drifted, failed int
+
+	for _, a := range plan.Actions {
+		var drift string
+		switch a.Kind {
+		case cleanupActionPolecatRemoved:
+			drift = planPolecatDrift(rigsByName[a.Rig], a)
+		case cleanupActionConvoyClosed:
+			drift = planConvoyDrift(townBeads, a)
+		default:
+			drift = fmt.Sprintf("unsupported plan action %q", a.Kind)
+		}
+		label := a.ID
+		if a.Rig != "" {
+			label = a.Rig + "/" + a.ID
+		}
+
+		if drift != "" {
+			fmt.Printf("  %s Skipped %s: %s\n", style.Warning.Render("⚠"), label, drift)
+			drifted++
+			continue
+		}
+		if dryRun {
+			fmt.Printf("  %s %s unchanged; would apply %s\n", style.Success.Render("✓"), label, a.Kind)
+			applied++
+			continue
+		}
+
+		switch a.Kind {
+		case cleanupActionPolecatRemoved:
+			r := rigsByName[a.Rig]
+			if err := stopPolecatSession(t, r, a.ID); err != nil {
+				fmt.Printf("  %s Skipped %s: %v\n", style.Warning.Render("⚠"), label, err)
+				failed++
+				continue
+			}
+			mgr := polecat.NewManager(r, git.NewGit(r.Path))
+			result, _ := mgr.RemoveAll([]string{a.ID}, polecat.RemoveOptions{Force: true, OnLocked: onLocked,
+				Timeout: cleanupPolecatTimeout})
+			if err := result.Outcomes[0].Err; err != nil {
+				fmt.Printf("  %s Failed to nuke %s: %v\n", style.Error.Render("✗"), label, err)
+				failed++
+				continue
+			}
+			closePolecatAgentBead(r, a.ID, "Nuked by gt cleanup --plan")
+			audit.record(cleanupActionPolecatRemoved, r.Name, a.ID)
+			audit.record(cleanupActionAgentBeadClosed, r.Name, polecat.AgentBeadID(r, a.ID))
+			fmt.Printf("  %s Nuked %s\n", style.Success.Render("✓"), label)
+
+		case cleanupActionConvoyClosed:
+			closeCmd := exec.Command("bd", "close", a.ID, "-r",
+				convoyCloseReason("All tracked issues completed", "gt cleanup --plan"))
+			closeCmd.Dir = townBeads
+			if out, err := closeCmd.CombinedOutput(); err != nil {
+				fmt.Printf("  %s Failed to close %s: %v %s\n", style.Error.Render("✗"), a.ID, err, string(out))
+				failed++
+				continue
+			}
+			audit.record(cleanupActionConvoyClosed, "", a.ID)
+			notifyConvoyCompletion(townBeads, a.ID, a.Title)
+			fmt.Printf("  %s Closed convoy %s\n", style.Success.Render("✓"), a.ID)
+		}
+		applied++
+	}
+
+	if len(audit.Actions) > 0 {
+		if err := appendCleanupAudit(townRoot, audit); err != nil {
+			style.PrintWarning("could not write audit log: %v", err)
+		}
+	}
+
+	fmt.Println()
+	verb := "applied"
+	if dryRun {
+		verb = "still valid"
+	}
+	fmt.Printf("%s %s %s, %s skipped (drifted)\n", style.Bold.Render("✓"),
+		style.Count(applied, "action", "actions"), verb, style.Count(drifted, "action", "actions"))
+	if failed > 0 {
+		return fmt.Errorf("%s failed", style.Count(failed, "action", "actions"))
+	}
+	return nil
+}
+
+// planPolecatDrift re-checks a planned polecat removal. It returns a
+// description of how the polecat drifted from its planned state, or "" if it
+// still matches.
+func planPolecatDrift(r *rig.Rig, a cleanupAction) string {
+	if r == nil {
+		return fmt.Sprintf("rig %s not found", a.Rig)
+	}
+	p, err := polecat.NewManager(r, git.NewGit(r.Path)).Get(a.ID)
+	if err != nil {
+		return fmt.Sprintf("polecat no longer exists (%v)", err)
+	}
+	if a.State != "" && p.State != a.State {
+		return fmt.Sprintf("state is now %s (planned as %s)", p.State, a.State)
+	}
+	return ""
+}
+
+// planConvoyDrift re-checks a planned convoy close: the convoy must still be
+// open and track exactly the planned issues, all closed.
+func planConvoyDrift(townBeads string, a cleanupAction) string {
+	status, err := convoyStatus(townBeads, a.ID)
+	if err != nil {
+		return err.Error()
+	}
+	if status != "open" {
+		return fmt.Sprintf("convoy is now %s", status)
+	}
+
+	planned := make([]trackedIssueInfo, len(a.Tracked))
+	for i, id := range a.Tracked {
+		planned[i] = trackedIssueInfo{ID: id, Status: "closed"}
+	}
+	if !trackedIssuesUnchanged(planned, getTrackedIssues(townBeads, a.ID)) {
+		return "tracked issues changed since the plan was made"
+	}
+	return ""
+}
+
+// convoyStatus returns the current status of a convoy bead.
+func convoyStatus(townBeads, convoyID string) (string, error) {
+	showCmd := exec.Command("bd", "show", convoyID, "--json")
+	showCmd.Dir = townBeads
+	var stdout bytes.Buffer
+	showCmd.Stdout = &stdout
+	if err := showCmd.Run(); err != nil {
+		return "", fmt.Errorf("convoy %s not found", convoyID)
+	}
+
+	var convoys []struct {
+		Status string `json:"status"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &convoys); err != nil || len(convoys) == 0 {
+		return "", fmt.Errorf("convoy %s not found", convoyID)
+	}
+	return convoys[0].Status, nil
+}
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+func TestLoadCleanupPlan(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name string, s CleanupSummary) string {
+		t.Helper()
+		data, err := json.Marshal(s)
+		if err != nil {
+			t.Fatalf("marshal: %v", err)
+		}
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, data, 0644); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+		return path
+	}
+
+	planPath := write("plan.json", CleanupSummary{DryRun: true, Actions: []cleanupAction{
+		{Kind: cleanupActionPolecatRemoved, Rig: "gastown", ID: "Toast", State: polecat.StateDone},
+		{Kind: cleanupActionConvoyClosed, ID: "hq-cv-1", Title: "Docs", Tracked: []string{"gt-a", "gt-b"}},
+	}})
+	plan, err := loadCleanupPlan(planPath)
+	if err != nil {
+		t.Fatalf("loadCleanupPlan: %v", err)
+	}
+	if len(plan.Actions) != 2 || plan.Actions[0].State != polecat.StateDone || len(plan.Actions[1].Tracked) != 2 {
+		t.Errorf("plan actions did not round-trip: %+v", plan.Actions)
+	}
+
+	// A summary of a real run is not a plan
+	if _, err := loadCleanupPlan(write("run.json", CleanupSummary{})); err == nil || !strings.Contains(err.Error(), "not a plan") {
+		t.Errorf("expected not-a-plan error, got %v", err)
+	}
+}
+
+func TestPlanPolecatDrift(t *testing.T) {
+	a := cleanupAction{Kind: cleanupActionPolecatRemoved, Rig: "gastown", ID: "Toast", State: polecat.StateDone}
+
+	if drift := planPolecatDrift(nil, a); !strings.Contains(drift, "rig gastown not found") {
+		t.Errorf("missing rig drift = %q", drift)
+	}
+
+	r := &rig.Rig{Name: "gastown", Path: t.TempDir()}
+	if drift := planPolecatDrift(r, a); !strings.Contains(drift, "no longer exists") {
+		t.Errorf("missing polecat drift = %q", drift)
+	}
+}
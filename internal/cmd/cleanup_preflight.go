@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// cleanupPreflight checks that a real run of the planned actions (a dry
+// run's audit entry) would succeed. It returns one problem per condition
+// that would make an action fail; nil means the real run should go through.
+// No bead, session, or worktree is changed; the only write is the
+// writability probe's temp file (see probeWritable).
+func cleanupPreflight(townRoot string, rigs []*rig.Rig, actions []cleanupAction, onLocked polecat.LockPolicy) []CleanupWarning {
+	if len(actions) == 0 {
+		return nil
+	}
+
+	var problems []CleanupWarning
+	fail := func(rigName, format string, args ...interface{}) {
+		problems = append(problems, CleanupWarning{Rig: rigName, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if _, err := exec.LookPath("bd"); err != nil {
+		fail("", "bd not found on PATH: no beads can be closed")
+	}
+
+	rigsByName := make(map[string]*rig.Rig, len(rigs))
+	for _, r := range rigs {
+		rigsByName[r.Name] = r
+	}
+
+	t := tmux.NewTmux()
+	probed := make(map[string]bool) // Directories already checked for writability
+	probeDir := func(rigName, dir, what string) {
+		if probed[dir] {
+			return
+		}
+		probed[dir] = true
+		if err := probeWritable(dir); err != nil {
+			fail(rigName, "%s not writable: %v", what, err)
+		}
+	}
+
+	for _, a := range actions {
+		switch a.Kind {
+		case cleanupActionConvoyClosed:
+			probeDir("", filepath.Join(townRoot, ".beads"), "town beads database")
+
+		case cleanupActionPolecatRemoved:
+			r := rigsByName[a.Rig]
+			if r == nil {
+				fail(a.Rig, "rig not found for polecat %s", a.ID)
+				continue
+			}
+			probeDir(r.Name, r.BeadsDir(), "beads database")
+			probeDir(r.Name, filepath.Join(r.Path, "polecats"), "polecats directory")
+
+			mgr := polecat.NewManager(r, git.NewGit(r.Path))
+			if locks := mgr.WorktreeLocks(a.ID); len(locks) > 0 && onLocked != polecat.LockForce {
+				fail(r.Name, "polecat %s: worktree locked by git (%s); --on-locked=%s would leave it",
+					a.ID, lockNames(locks), onLocked)
+			}
+
+			sessMgr := polecat.NewSessionManager(t, r)
+			if _, err := sessMgr.IsRunning(a.ID); err != nil {
+				fail(r.Name, "polecat %s: can't check session %s, so it can't be killed: %v",
+					a.ID, sessMgr.SessionName(a.ID), err)
+			}
+		}
+	}
+	return problems
+}
+
+// probeWritable checks that a file can be created in dir by creating a
+// hidden .gt-preflight-* file there and removing it again, which tests what
+// the run will do rather than inferring it from permission bits.
+func probeWritable(dir string) error {
+	f, err := os.CreateTemp(dir, ".gt-preflight-*")
+	if err != nil {
+		return err
+	}
+	name := f.Name()
+	_ = f.Close()
+	return os.Remove(name)
+}
+
+// printPreflightProblems renders the problems found by --strict.
+func printPreflightProblems(problems []CleanupWarning) {
+	if len(problems) == 0 {
+		fmt.Fprintf(cleanupOut, "\n%s Preflight passed: the real run should succeed\n", style.Success.Render("✓"))
+		return
+	}
+	fmt.Fprintf(cleanupOut, "\n%s\n", style.Error.Render(fmt.Sprintf("✗ Preflight found %s:",
+		style.Count(len(problems), "problem", "problems"))))
+	for _, p := range problems {
+		fmt.Fprintf(cleanupOut, "  - %s\n", p)
+	}
+}
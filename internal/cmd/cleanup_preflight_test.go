@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/polecat"
+)
+
+func TestCleanupPreflight(t *testing.T) {
+	// A PATH with tmux (so sessions can be checked) but no bd
+	tmuxPath, err := exec.LookPath("tmux")
+	if err != nil {
+		t.Skip("tmux not installed")
+	}
+	bin := t.TempDir()
+	if err := os.Symlink(tmuxPath, filepath.Join(bin, "tmux")); err != nil {
+		t.Fatalf("symlink: %v", err)
+	}
+	t.Setenv("PATH", bin)
+
+	rigs := newCleanupFixtureRigs(t, 1)
+	r := rigs[0]
+
+	// Toast's worktree is locked by git
+	clone := filepath.Join(r.Path, "polecats", "Toast", r.Name)
+	gitDir := filepath.Join(r.Path, "mayor", "rig", ".git", "worktrees", "Toast")
+	for _, dir := range []string{clone, gitDir, filepath.Join(r.Path, ".beads")} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(clone, ".git"), []byte("gitdir: "+gitDir+"\n"), 0644); err != nil {
+		t.Fatalf("write .git: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(gitDir, "index.lock"), nil, 0644); err != nil {
+		t.Fatalf("write lock: %v", err)
+	}
+
+	actions := []cleanupAction{
+		{Kind: cleanupActionPolecatRemoved, Rig: r.Name, ID: "Toast"},
+		{Kind: cleanupActionPolecatRemoved, Rig: r.Name, ID: "Cheedo"},
+	}
+
+	problems := cleanupPreflight(t.TempDir(), rigs, actions, polecat.LockSkip)
+	var got []string
+	for _, p := range problems {
+		got = append(got, p.String())
+	}
+	joined := strings.Join(got, "\n")
+	if len(problems) != 2 || !strings.Contains(joined, "bd not found") || !strings.Contains(joined, "Toast: worktree locked") {
+		t.Errorf("problems = %q, want bd missing and Toast locked", got)
+	}
+
+	// --on-locked=force clears the locks, so Toast is no longer a problem
+	problems = cleanupPreflight(t.TempDir(), rigs, actions, polecat.LockForce)
+	if len(problems) != 1 {
+		t.Errorf("problems with force = %v, want only bd missing", problems)
+	}
+
+	if problems := cleanupPreflight(t.TempDir(), rigs, nil, polecat.LockSkip); problems != nil {
+		t.Errorf("no actions: problems = %v, want none", problems)
+	}
+}
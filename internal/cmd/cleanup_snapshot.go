@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// cleanupSnapshot is the --snapshot-before record of the town as it was
+// before a cleanup run changed anything.
+type cleanupSnapshot struct {
+	TakenAt time.Time            `json:"taken_at"`
+	Actor   string               `json:"actor"`
+	Rigs    []cleanupRigSnapshot `json:"rigs"`
+
+	// Convoys are all open convoys with their tracked issues.
+	Convoys      []convoyPreview `json:"convoys"`
+	ConvoysError string          `json:"convoys_error,omitempty"` // Why convoys couldn't be listed
+}
+
+// cleanupRigSnapshot is one rig's polecats and branches in a cleanupSnapshot.
+type cleanupRigSnapshot struct {
+	Rig      string                   `json:"rig"`
+	Polecats []cleanupPolecatSnapshot `json:"polecats"`
+	Branches []string                 `json:"branches"`
+	Error    string                   `json:"error,omitempty"` // What couldn't be read
+}
+
+// cleanupPolecatSnapshot is a polecat with its session and worktree state.
+type cleanupPolecatSnapshot struct {
+	*polecat.Polecat
+	SessionRunning bool      `json:"session_running"`
+	Git            *GitState `json:"git,omitempty"` // Nil when the worktree can't be read
+}
+
+// cleanupSnapshotDir returns where --snapshot-before writes snapshots.
+func cleanupSnapshotDir(townRoot string) string {
+	return filepath.Join(townRoot, "mayor", "snapshots")
+}
+
+// takeCleanupSnapshot records the polecats and branches of rigs and the
+// town's open convoys. Anything that can't be read is noted in the snapshot
+// rather than failing it.
+func takeCleanupSnapshot(ctx context.Context, townRoot string, rigs []*rig.Rig) *cleanupSnapshot {
+	snap := &cleanupSnapshot{TakenAt: time.Now().UTC(), Actor: detectSender(),
+		Rigs: []cleanupRigSnapshot{}, Convoys: []convoyPreview{}}
+
+	t := tmux.NewTmux()
+	for _, r := range rigs {
+		rs := cleanupRigSnapshot{Rig: r.Name, Polecats: []cleanupPolecatSnapshot{}, Branches: []string{}}
+		mgr := polecat.NewManager(r, git.NewGit(r.Path))
+		sessMgr := polecat.NewSessionManager(t, r)
+
+		polecats, err := mgr.List()
+		if err != nil {
+			rs.Error = fmt.Sprintf("listing polecats: %v", err)
+		}
+		for _, p := range polecats {
+			ps := cleanupPolecatSnapshot{Polecat: p}
+			ps.SessionRunning, _ = sessMgr.IsRunning(p.Name)
+			if state, err := getGitState(p.ClonePath); err == nil {
+				ps.Git = state
+			}
+			rs.Polecats = append(rs.Polecats, ps)
+		}
+
+		if branches, err := mgr.Branches(); err != nil {
+			if rs.Error == "" {
+				rs.Error = err.Error()
+			}
+		} else if branches != nil {
+			rs.Branches = branches
+		}
+		snap.Rigs = append(snap.Rigs, rs)
+	}
+
+	townBeads := filepath.Join(townRoot, ".beads")
+	convoys, err := listOpenConvoys(ctx, townBeads)
+	if err != nil {
+		snap.ConvoysError = err.Error()
+	}
+	for _, c := range convoys {
+		tracked := getTrackedIssues(townBeads, c.ID)
+		if tracked == nil {
+			tracked = []trackedIssueInfo{}
+		}
+		snap.Convoys = append(snap.Convoys, convoyPreview{ID: c.ID, Title: c.Title, Tracked: tracked})
+	}
+	return snap
+}
+
+// writeCleanupSnapshot writes snap to a file under mayor/snapshots/ named
+// for when it was taken, and returns the file's path.
+func writeCleanupSnapshot(townRoot string, snap *cleanupSnapshot) (string, error) {
+	dir := cleanupSnapshotDir(townRoot)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("creating snapshot dir: %w", err)
+	}
+	data, err := json.MarshalIndent(snap, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("encoding snapshot: %w", err)
+	}
+	path := filepath.Join(dir, "cleanup-"+snap.TakenAt.Format("20060102T150405.000Z")+".json")
+	if err := os.WriteFile(path, append(data, '\n'), 0644); err != nil {
+		return "", fmt.Errorf("writing snapshot: %w", err)
+	}
+	return path, nil
+}
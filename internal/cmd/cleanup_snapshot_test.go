@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/polecat"
+)
+
+func TestWriteCleanupSnapshot(t *testing.T) {
+	townRoot := t.TempDir()
+	snap := &cleanupSnapshot{
+		TakenAt: time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC),
+		Actor:   "overseer",
+		Rigs: []cleanupRigSnapshot{{
+			Rig:      "gastown",
+			Polecats: []cleanupPolecatSnapshot{{Polecat: &polecat.Polecat{Name: "Toast", Rig: "gastown", State: polecat.StateDone}}},
+			Branches: []string{"main", "polecat/Toast"},
+		}},
+		Convoys: []convoyPreview{{ID: "hq-cv-1", Title: "Ship it", Tracked: []trackedIssueInfo{{ID: "gt-1", Status: "open"}}}},
+	}
+
+	path, err := writeCleanupSnapshot(townRoot, snap)
+	if err != nil {
+		t.Fatalf("writeCleanupSnapshot: %v", err)
+	}
+	if want := filepath.Join(townRoot, "mayor", "snapshots", "cleanup-20260304T050607.000Z.json"); path != want {
+		t.Errorf("path = %s, want %s", path, want)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got struct {
+		Actor string `json:"actor"`
+		Rigs  []struct {
+			Rig      string   `json:"rig"`
+			Branches []string `json:"branches"`
+			Polecats []struct {
+				Name  string `json:"name"`
+				State string `json:"state"`
+			} `json:"polecats"`
+		} `json:"rigs"`
+		Convoys []struct {
+			ID      string `json:"id"`
+			Tracked []struct {
+				ID string `json:"id"`
+			} `json:"tracked"`
+		} `json:"convoys"`
+	}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("snapshot is not valid JSON: %v", err)
+	}
+	if got.Actor != "overseer" || len(got.Rigs) != 1 || len(got.Convoys) != 1 {
+		t.Fatalf("snapshot = %+v", got)
+	}
+	rs := got.Rigs[0]
+	if len(rs.Polecats) != 1 || rs.Polecats[0].Name != "Toast" || rs.Polecats[0].State != "done" {
+		t.Errorf("polecats = %+v, want Toast (done) flattened into the entry", rs.Polecats)
+	}
+	if len(rs.Branches) != 2 {
+		t.Errorf("branches = %v, want 2", rs.Branches)
+	}
+	if c := got.Convoys[0]; c.ID != "hq-cv-1" || len(c.Tracked) != 1 || c.Tracked[0].ID != "gt-1" {
+		t.Errorf("convoys = %+v, want hq-cv-1 tracking gt-1", got.Convoys)
+	}
+}
+
+func TestCleanupAuditRecordsSnapshot(t *testing.T) {
+	townRoot := t.TempDir()
+	entry := &cleanupAuditEntry{Timestamp: time.Now().UTC(), Actor: "overseer", Snapshot: "/town/mayor/snapshots/x.json"}
+	entry.record(cleanupActionConvoyClosed, "", "hq-cv-1")
+	if err := appendCleanupAudit(townRoot, entry); err != nil {
+		t.Fatal(err)
+	}
+	last, err := lastCleanupAudit(townRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if last.Snapshot != entry.Snapshot {
+		t.Errorf("Snapshot = %q, want %q", last.Snapshot, entry.Snapshot)
+	}
+}
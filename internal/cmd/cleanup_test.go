@@ -0,0 +1,835 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+func TestCleanupWarningsCollectAndPrint(t *testing.T) {
+	var buf bytes.Buffer
+	oldOut := cleanupOut
+	cleanupOut = &buf
+	defer func() { cleanupOut = oldOut }()
+
+	w := &cleanupWarnings{}
+	w.add("gastown", "gc failed: %v", "boom")
+	w.add("", "convoy cleanup had errors")
+
+	if buf.Len() != 0 {
+		t.Errorf("non-verbose add should not print, got %q", buf.String())
+	}
+	if len(w.items) != 2 {
+		t.Fatalf("expected 2 warnings, got %d", len(w.items))
+	}
+
+	w.print()
+	out := buf.String()
+	if !strings.Contains(out, "Warnings (2):") {
+		t.Errorf("missing warnings header in %q", out)
+	}
+	if !strings.Contains(out, "gastown: gc failed: boom") {
+		t.Errorf("missing rig-prefixed warning in %q", out)
+	}
+	if !strings.Contains(out, "  - convoy cleanup had errors") {
+		t.Errorf("missing town-level warning in %q", out)
+	}
+}
+
+func TestGroupCleanupWarnings(t *testing.T) {
+	var items []CleanupWarning
+	for _, r := range []string{"gastown", "gastown", "beads"} {
+		for _, name := range []string{"Toast", "Nux"} {
+			items = append(items, CleanupWarning{Rig: r, Message: "failed to nuke " + name + ": database is locked"})
+		}
+	}
+	items = append(items,
+		CleanupWarning{Rig: "gastown", Message: "git gc failed: exit status 1"},
+		CleanupWarning{Message: "could not write audit log"},
+		CleanupWarning{Message: "could not write audit log"},
+	)
+
+	var got []string
+	for _, g := range groupCleanupWarnings(items) {
+		got = append(got, g.String())
+	}
+	want := []string{
+		"failed to nuke Toast: database is locked (×3 across 2 rigs: gastown, beads)",
+		"failed to nuke Nux: database is locked (×3 across 2 rigs: gastown, beads)",
+		"gastown: git gc failed: exit status 1",
+		"could not write audit log (×2)",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("groups =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	// Distinct messages sharing the underlying error collapse to the error
+	items = []CleanupWarning{
+		{Rig: "gastown", Message: "failed to nuke Toast: database is locked"},
+		{Rig: "gastown", Message: "failed to nuke Nux: database is locked"},
+		{Rig: "gastown", Message: "failed to nuke Ace: database is locked"},
+	}
+	groups := groupCleanupWarnings(items)
+	if len(groups) != 1 || groups[0].String() != "gastown: database is locked (×3)" {
+		t.Errorf("groups = %v, want one \"gastown: database is locked (×3)\"", groups)
+	}
+}
+
+func TestCleanupPhases(t *testing.T) {
+	tests := []struct {
+		polecats, convoys, gc bool
+		want                  cleanupPhaseSet
+		wantErr               bool
+	}{
+		{want: cleanupPhaseSet{Polecats: true, Convoys: true}},
+		{gc: true, want: cleanupPhaseSet{Polecats: true, Convoys: true, GC: true}},
+		{polecats: true, want: cleanupPhaseSet{Polecats: true}},
+		{polecats: true, gc: true, want: cleanupPhaseSet{Polecats: true, GC: true}},
+		{convoys: true, want: cleanupPhaseSet{Convoys: true}},
+		{convoys: true, gc: true, wantErr: true},
+		{polecats: true, convoys: true, wantErr: true},
+		{polecats: true, convoys: true, gc: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		name := fmt.Sprintf("polecats=%v,convoys=%v,gc=%v", tt.polecats, tt.convoys, tt.gc)
+		t.Run(name, func(t *testing.T) {
+			got, err := cleanupPhases(tt.polecats, tt.convoys, tt.gc)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("got %+v, want error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCleanupWarningsRigErrorFatal(t *testing.T) {
+	// Lenient by default: a warning, nothing cancelled
+	w := &cleanupWarnings{}
+	w.rigError("gastown", "error listing polecats: %v", "boom")
+	if len(w.items) != 1 {
+		t.Fatalf("warnings = %v, want one", w.items)
+	}
+
+	ctx, abort := context.WithCancelCause(context.Background())
+	defer abort(nil)
+	w = &cleanupWarnings{abort: abort}
+	w.rigError("gastown", "error listing polecats: %v", "boom")
+	w.rigError("beads", "gc failed: %v", "later")
+
+	if ctx.Err() == nil {
+		t.Fatal("first rig error did not abort the run")
+	}
+	cause := context.Cause(ctx)
+	if !errors.Is(cause, errCleanupRigFailed) || !strings.Contains(cause.Error(), "gastown: error listing polecats: boom") {
+		t.Errorf("cause = %v, want the first rig error", cause)
+	}
+	if len(w.items) != 2 {
+		t.Errorf("warnings = %v, want both recorded", w.items)
+	}
+}
+
+func TestCleanupWarningsVerboseEchoes(t *testing.T) {
+	var buf bytes.Buffer
+	oldOut := cleanupOut
+	cleanupOut = &buf
+	defer func() { cleanupOut = oldOut }()
+
+	w := &cleanupWarnings{verbose: true}
+	w.add("gastown", "error listing polecats")
+
+	if !strings.Contains(buf.String(), "gastown: error listing polecats") {
+		t.Errorf("verbose add should echo warning, got %q", buf.String())
+	}
+}
+
+func TestCleanupWarningsPrintEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	oldOut := cleanupOut
+	cleanupOut = &buf
+	defer func() { cleanupOut = oldOut }()
+
+	(&cleanupWarnings{}).print()
+	if buf.Len() != 0 {
+		t.Errorf("empty warnings should print nothing, got %q", buf.String())
+	}
+}
+
+func newCleanupEnvTestCmd(dryRun, gc *bool) *cobra.Command {
+	cmd := &cobra.Command{Use: "cleanup"}
+	cmd.Flags().BoolVar(dryRun, "dry-run", false, "")
+	cmd.Flags().BoolVar(gc, "gc", false, "")
+	return cmd
+}
+
+func TestApplyCleanupEnvDefaults(t *testing.T) {
+	t.Setenv("GT_CLEANUP_DRY_RUN", "1")
+	t.Setenv("GT_CLEANUP_GC", "true")
+
+	var dryRun, gc bool
+	cmd := newCleanupEnvTestCmd(&dryRun, &gc)
+	if err := cmd.Flags().Parse(nil); err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if err := applyCleanupEnvDefaults(cmd, nil); err != nil {
+		t.Fatalf("applyCleanupEnvDefaults: %v", err)
+	}
+	if !dryRun || !gc {
+		t.Errorf("env defaults not applied: dryRun=%v gc=%v", dryRun, gc)
+	}
+}
+
+func TestApplyCleanupConfigDefaults(t *testing.T) {
+	t.Setenv("GT_CLEANUP_GC", "false")
+
+	var dryRun, gc bool
+	cmd := newCleanupEnvTestCmd(&dryRun, &gc)
+	if err := cmd.Flags().Parse(nil); err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if err := applyCleanupEnvDefaults(cmd, nil); err != nil {
+		t.Fatalf("applyCleanupEnvDefaults: %v", err)
+	}
+	if err := applyCleanupConfigDefaults(cmd, map[string]string{"dry-run": "true", "gc": "true"}); err != nil {
+		t.Fatalf("applyCleanupConfigDefaults: %v", err)
+	}
+	if !dryRun {
+		t.Error("cleanup_defaults dry-run not applied")
+	}
+	if gc {
+		t.Error("GT_CLEANUP_GC should win over cleanup_defaults")
+	}
+
+	if err := applyCleanupConfigDefaults(cmd, map[string]string{"undo": "true"}); err == nil {
+		t.Error("expected an error for a flag without a GT_CLEANUP_* variable")
+	}
+}
+
+func TestApplyCleanupEnvDefaultsExplicitFlagWins(t *testing.T) {
+	t.Setenv("GT_CLEANUP_DRY_RUN", "true")
+
+	var dryRun, gc bool
+	cmd := newCleanupEnvTestCmd(&dryRun, &gc)
+	if err := cmd.Flags().Parse([]string{"--dry-run=false"}); err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if err := applyCleanupEnvDefaults(cmd, nil); err != nil {
+		t.Fatalf("applyCleanupEnvDefaults: %v", err)
+	}
+	if dryRun {
+		t.Error("explicit --dry-run=false should override GT_CLEANUP_DRY_RUN")
+	}
+}
+
+func TestApplyCleanupEnvDefaultsInvalidValue(t *testing.T) {
+	t.Setenv("GT_CLEANUP_GC", "maybe")
+
+	var dryRun, gc bool
+	cmd := newCleanupEnvTestCmd(&dryRun, &gc)
+	if err := cmd.Flags().Parse(nil); err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	err := applyCleanupEnvDefaults(cmd, nil)
+	if err == nil || !strings.Contains(err.Error(), "GT_CLEANUP_GC") {
+		t.Errorf("expected error naming GT_CLEANUP_GC, got %v", err)
+	}
+}
+
+func TestCleanupEnvDefaultsMatchHelp(t *testing.T) {
+	// The flags listed under "These flags have a GT_CLEANUP_<FLAG> variable"
+	_, list, ok := strings.Cut(cleanupCmd.Long, "(dashes\nbecome underscores):\n")
+	if !ok {
+		t.Fatal("cleanup help no longer lists the GT_CLEANUP_* flags")
+	}
+	list, _, _ = strings.Cut(list, "\n\n")
+	documented := make(map[string]bool)
+	for _, f := range strings.Fields(list) {
+		documented[strings.TrimPrefix(f, "--")] = true
+	}
+
+	wired := make(map[string]string)
+	for _, d := range cleanupEnvDefaults {
+		wired[d.flag] = d.env
+	}
+
+	cleanupCmd.Flags().VisitAll(func(f *pflag.Flag) {
+		env, ok := wired[f.Name]
+		if ok != documented[f.Name] {
+			t.Errorf("--%s: has env default = %v, documented in help = %v", f.Name, ok, documented[f.Name])
+		}
+		if want := "GT_CLEANUP_" + strings.ToUpper(strings.ReplaceAll(f.Name, "-", "_")); ok && env != want {
+			t.Errorf("--%s: env = %s, want %s", f.Name, env, want)
+		}
+		delete(documented, f.Name)
+		delete(wired, f.Name)
+	})
+	for name := range documented {
+		t.Errorf("help documents GT_CLEANUP_* for unknown flag --%s", name)
+	}
+	for name := range wired {
+		t.Errorf("cleanupEnvDefaults names unknown flag --%s", name)
+	}
+}
+
+func TestShouldSuggestClose(t *testing.T) {
+	re := regexp.MustCompile(`docs`)
+	tests := []struct {
+		name  string
+		issue trackedIssueInfo
+		re    *regexp.Regexp
+		want  bool
+	}{
+		{"assigned to me", trackedIssueInfo{ID: "gt-1", Assignee: "gastown/crew/joe"}, nil, true},
+		{"worked by me", trackedIssueInfo{ID: "gt-2", Worker: "gastown/crew/joe"}, nil, true},
+		{"title matches", trackedIssueInfo{ID: "gt-3", Title: "Update docs"}, re, true},
+		{"id matches", trackedIssueInfo{ID: "docs-4"}, re, true},
+		{"someone else, no pattern", trackedIssueInfo{ID: "gt-5", Assignee: "gastown/polecats/nux"}, nil, false},
+		{"no match", trackedIssueInfo{ID: "gt-6", Title: "Fix parser"}, re, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := shouldSuggestClose(tt.issue, tt.re, "gastown/crew/joe"); got != tt.want {
+				t.Errorf("shouldSuggestClose() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrintBlockedConvoysSuggestions(t *testing.T) {
+	var buf bytes.Buffer
+	oldOut, oldSuggest := cleanupOut, cleanupSuggest
+	cleanupOut, cleanupSuggest = &buf, true
+	defer func() { cleanupOut, cleanupSuggest = oldOut, oldSuggest }()
+
+	blocked := []convoyPreview{{
+		ID:    "hq-cv-1",
+		Title: "Release",
+		Open: []trackedIssueInfo{
+			{ID: "gt-1", Title: "Write docs", Status: "open"},
+			{ID: "gt-2", Title: "Fix bug", Status: "in_progress", Assignee: "gastown/polecats/nux"},
+		},
+	}}
+	printBlockedConvoys(blocked, regexp.MustCompile(`docs`), "overseer")
+
+	out := buf.String()
+	if !strings.Contains(out, "Blocked convoy: hq-cv-1") {
+		t.Errorf("missing blocked convoy line:\n%s", out)
+	}
+	if !strings.Contains(out, "gastown/polecats/nux") || !strings.Contains(out, "unassigned") {
+		t.Errorf("missing assignee info:\n%s", out)
+	}
+	if !strings.Contains(out, `bd close gt-1 -r "Unblocks convoy hq-cv-1"`) {
+		t.Errorf("missing suggestion for gt-1:\n%s", out)
+	}
+	if strings.Contains(out, "bd close gt-2") {
+		t.Errorf("unexpected suggestion for gt-2:\n%s", out)
+	}
+}
+
+func TestFlushCleanupOut(t *testing.T) {
+	var buf bytes.Buffer
+	oldOut := cleanupOut
+	bw := bufio.NewWriter(&buf)
+	cleanupOut = bw
+	defer func() { cleanupOut = oldOut }()
+
+	fmt.Fprintf(cleanupOut, "rig output\n")
+	if buf.Len() != 0 {
+		t.Fatalf("output written before flush: %q", buf.String())
+	}
+	flushCleanupOut()
+	if buf.String() != "rig output\n" {
+		t.Errorf("after flush = %q, want %q", buf.String(), "rig output\n")
+	}
+
+	// Unbuffered writers are a no-op
+	cleanupOut = &buf
+	flushCleanupOut()
+}
+
+func TestFormatBytes(t *testing.T) {
+	tests := []struct {
+		n    int64
+		want string
+	}{
+		{0, "0 B"},
+		{512, "512 B"},
+		{1536, "1.5 KiB"},
+		{5 * 1024 * 1024, "5.0 MiB"},
+	}
+	for _, tt := range tests {
+		if got := formatBytes(tt.n); got != tt.want {
+			t.Errorf("formatBytes(%d) = %q, want %q", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestPolecatAgeGate(t *testing.T) {
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		changedAt time.Time
+		wantDesc  string
+		wantOK    bool
+	}{
+		{"old enough", now.Add(-36 * time.Hour), "1 day ago", true},
+		{"too young", now.Add(-2 * time.Hour), "2 hours ago", false},
+		{"days", now.Add(-72 * time.Hour), "3 days ago", true},
+		{"unknown", time.Time{}, "age unknown", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok := polecatAgeGate(tt.changedAt, 24*time.Hour, now)
+			if ok != tt.wantOK {
+				t.Errorf("eligible = %v, want %v", ok, tt.wantOK)
+			}
+			if got := describePolecatAge(tt.changedAt, now); got != tt.wantDesc {
+				t.Errorf("describePolecatAge = %q, want %q", got, tt.wantDesc)
+			}
+		})
+	}
+}
+
+// newCleanupFixtureRigs creates n rigs, each with a few polecat directories.
+// The rigs have no git repo or beads, so every rig exercises the warning path.
+func newCleanupFixtureRigs(t *testing.T, n int) []*rig.Rig {
+	t.Helper()
+	townRoot := t.TempDir()
+	var rigs []*rig.Rig
+	for i := 0; i < n; i++ {
+		name := fmt.Sprintf("rig%02d", i)
+		rigPath := filepath.Join(townRoot, name)
+		for _, dir := range []string{"polecats/Toast", "polecats/Cheedo", "mayor/rig"} {
+			if err := os.MkdirAll(filepath.Join(rigPath, dir), 0755); err != nil {
+				t.Fatalf("mkdir: %v", err)
+			}
+		}
+		rigs = append(rigs, &rig.Rig{Name: name, Path: rigPath})
+	}
+	return rigs
+}
+
+// TestCleanupParallelRigs runs the per-rig cleanup passes with many workers.
+// Run with -race to check the shared counters, warnings, and output.
+func TestCleanupParallelRigs(t *testing.T) {
+	var buf bytes.Buffer
+	oldOut, oldJobs := cleanupOut, cleanupJobs
+	cleanupOut, cleanupJobs = &buf, 16
+	defer func() { cleanupOut, cleanupJobs = oldOut, oldJobs }()
+
+	rigs := newCleanupFixtureRigs(t, 24)
+	warnings := &cleanupWarnings{verbose: true}
+	audit := &cleanupAuditEntry{}
+
+	if _, err := cleanupDonePolecats(context.Background(), t.TempDir(), rigs, true, 0, warnings, audit); err != nil {
+		t.Fatalf("cleanupDonePolecats: %v", err)
+	}
+	gced, err := cleanupStaleBranches(context.Background(), rigs, true, warnings)
+	if err != nil {
+		t.Fatalf("cleanupStaleBranches: %v", err)
+	}
+	if gced != 0 {
+		t.Errorf("gced = %d, want 0 (no repos)", gced)
+	}
+	if len(warnings.items) != len(rigs) {
+		t.Errorf("warnings = %d, want one gc warning per rig (%d)", len(warnings.items), len(rigs))
+	}
+}
+
+func TestCleanupShowCleanAndBreakdown(t *testing.T) {
+	var buf bytes.Buffer
+	oldOut, oldShow := cleanupOut, cleanupShowClean
+	defer func() { cleanupOut, cleanupShowClean = oldOut, oldShow }()
+	cleanupOut = &buf
+
+	rigs := newCleanupFixtureRigs(t, 3)
+
+	// Quiet by default: clean rigs print nothing, but are in the breakdown
+	cleanupShowClean = false
+	result, err := cleanupDonePolecats(context.Background(), t.TempDir(), rigs, true, 0, &cleanupWarnings{}, &cleanupAuditEntry{})
+	if err != nil {
+		t.Fatalf("cleanupDonePolecats: %v", err)
+	}
+	if strings.Contains(buf.String(), "clean") {
+		t.Errorf("clean rigs printed without --show-clean: %q", buf.String())
+	}
+	if len(result.Rigs) != len(rigs) {
+		t.Fatalf("breakdown has %d rigs, want %d", len(result.Rigs), len(rigs))
+	}
+	for i, rs := range result.Rigs {
+		if rs.Rig != rigs[i].Name || rs.Polecats != 2 || !rs.Clean() {
+			t.Errorf("rig %d breakdown = %+v, want clean %s with 2 polecats", i, rs, rigs[i].Name)
+		}
+	}
+
+	cleanupShowClean = true
+	if _, err := cleanupDonePolecats(context.Background(), t.TempDir(), rigs, true, 0, &cleanupWarnings{}, &cleanupAuditEntry{}); err != nil {
+		t.Fatalf("cleanupDonePolecats: %v", err)
+	}
+	for _, r := range rigs {
+		if !strings.Contains(buf.String(), r.Name+": clean") {
+			t.Errorf("missing %q in %q", r.Name+": clean", buf.String())
+		}
+	}
+}
+
+func TestForEachRigParallel(t *testing.T) {
+	var buf bytes.Buffer
+	oldOut := cleanupOut
+	cleanupOut = &buf
+	defer func() { cleanupOut = oldOut }()
+
+	rigs := newCleanupFixtureRigs(t, 32)
+	warnings := &cleanupWarnings{}
+	audit := &cleanupAuditEntry{}
+	var count atomic.Int64
+
+	forEachRig(rigs, 16, func(r *rig.Rig, out io.Writer) {
+		for i := 0; i < 10; i++ {
+			count.Add(1)
+			audit.record(cleanupActionPolecatRemoved, r.Name, fmt.Sprintf("p%d", i))
+		}
+		warnings.add(r.Name, "checked")
+		fmt.Fprintf(out, "%s\n", r.Name)
+	})
+
+	if got := count.Load(); got != int64(len(rigs)*10) {
+		t.Errorf("count = %d, want %d", got, len(rigs)*10)
+	}
+	if len(audit.Actions) != len(rigs)*10 {
+		t.Errorf("audit actions = %d, want %d", len(audit.Actions), len(rigs)*10)
+	}
+	if len(warnings.items) != len(rigs) {
+		t.Errorf("warnings = %d, want %d", len(warnings.items), len(rigs))
+	}
+
+	// Output is emitted in rig order regardless of completion order
+	var want strings.Builder
+	for _, r := range rigs {
+		want.WriteString(r.Name + "\n")
+	}
+	if buf.String() != want.String() {
+		t.Errorf("output order:\n%s\nwant:\n%s", buf.String(), want.String())
+	}
+}
+
+func TestCleanupTargetsAnomalous(t *testing.T) {
+	tests := []struct {
+		name                string
+		targets, total, max int
+		want                bool
+	}{
+		{"normal", 5, 40, 100, false},
+		{"over max", 101, 1000, 100, true},
+		{"max disabled", 101, 1000, 0, false},
+		{"majority of fleet", 30, 40, 100, true},
+		{"small town majority", 3, 4, 100, false},
+		{"exactly half", 20, 40, 100, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cleanupTargetsAnomalous(tt.targets, tt.total, tt.max); got != tt.want {
+				t.Errorf("cleanupTargetsAnomalous(%d, %d, %d) = %v, want %v", tt.targets, tt.total, tt.max, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckCleanupTargetsRequiresYes(t *testing.T) {
+	var buf bytes.Buffer
+	oldOut, oldMax, oldYes := cleanupOut, cleanupMaxTargets, cleanupYes
+	cleanupOut, cleanupMaxTargets, cleanupYes = &buf, 100, false
+	defer func() { cleanupOut, cleanupMaxTargets, cleanupYes = oldOut, oldMax, oldYes }()
+
+	if err := checkCleanupTargets(5, 40, false); err != nil {
+		t.Errorf("normal cleanup blocked: %v", err)
+	}
+
+	if err := checkCleanupTargets(150, 200, false); !errors.Is(err, errTooManyCleanupTargets) {
+		t.Errorf("anomalous cleanup err = %v, want errTooManyCleanupTargets", err)
+	}
+	if !strings.Contains(buf.String(), "150 of 200") {
+		t.Errorf("anomaly not reported:\n%s", buf.String())
+	}
+
+	if err := checkCleanupTargets(150, 200, true); err != nil {
+		t.Errorf("dry-run should only report, got %v", err)
+	}
+
+	cleanupYes = true
+	if err := checkCleanupTargets(150, 200, false); err != nil {
+		t.Errorf("--yes should confirm, got %v", err)
+	}
+}
+
+func TestExportConvoySnapshot(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "convoys.json")
+	convoys := []convoyPreview{{
+		ID:      "hq-cv-1",
+		Title:   "Release",
+		Tracked: []trackedIssueInfo{{ID: "gt-1", Title: "Write docs", Status: "closed"}},
+		Open:    nil,
+	}}
+
+	if err := exportConvoySnapshot(path, convoys, true); err != nil {
+		t.Fatalf("exportConvoySnapshot: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading snapshot: %v", err)
+	}
+	var got convoyExport
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("parsing snapshot: %v", err)
+	}
+	if !got.DryRun || len(got.Convoys) != 1 {
+		t.Fatalf("snapshot = %+v, want one dry-run convoy", got)
+	}
+	if c := got.Convoys[0]; c.ID != "hq-cv-1" || len(c.Tracked) != 1 || c.Tracked[0].ID != "gt-1" {
+		t.Errorf("convoy = %+v, want hq-cv-1 tracking gt-1", c)
+	}
+}
+
+func TestCleanupDonePolecatsInterrupted(t *testing.T) {
+	var buf bytes.Buffer
+	oldOut := cleanupOut
+	cleanupOut = &buf
+	defer func() { cleanupOut = oldOut }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	warnings := &cleanupWarnings{}
+	result, err := cleanupDonePolecats(ctx, t.TempDir(), newCleanupFixtureRigs(t, 3), false, 0, warnings, &cleanupAuditEntry{})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+	if result.Nuked != 0 || len(warnings.items) != 0 {
+		t.Errorf("result = %+v, warnings = %v; want nothing done and no warnings", result, warnings.items)
+	}
+}
+
+func TestDiscoverCleanupRigsMissingRegistry(t *testing.T) {
+	townRoot := t.TempDir()
+
+	rigs, nested, err := discoverCleanupRigs(townRoot, true)
+	if err != nil {
+		t.Fatalf("discoverCleanupRigs without rigs.json: %v", err)
+	}
+	if len(rigs) != 0 || len(nested) != 0 {
+		t.Errorf("rigs = %d, nested = %d; want none", len(rigs), len(nested))
+	}
+}
+
+func TestDiscoverCleanupRigsMalformedRegistry(t *testing.T) {
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, "mayor"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(townRoot, "mayor", "rigs.json"), []byte(`{"rigs": {`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err := discoverCleanupRigs(townRoot, true)
+	if err == nil {
+		t.Fatal("discoverCleanupRigs with malformed rigs.json succeeded, want error")
+	}
+	if !strings.Contains(err.Error(), "rigs.json") {
+		t.Errorf("error = %v, want it to name rigs.json", err)
+	}
+}
+
+// TestCleanupExportClosesExactlyExportedConvoys checks that a convoy which
+// completes between the --export snapshot and the close isn't closed
+// without being exported.
+func TestCleanupExportClosesExactlyExportedConvoys(t *testing.T) {
+	dir := t.TempDir()
+	townBeads := filepath.Join(dir, ".beads")
+	if err := os.MkdirAll(townBeads, 0755); err != nil {
+		t.Fatal(err)
+	}
+	binDir := filepath.Join(dir, "bin")
+	if err := os.MkdirAll(binDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	logPath := filepath.Join(dir, "bd.log")
+
+	// The first convoy listing sees one complete convoy; any later listing
+	// also sees a second one that completed in the meantime.
+	bdScript := `#!/bin/sh
+[ "$1" = "--no-daemon" ] && shift
+case "$1" in
+  list)
+    if [ -f "` + dir + `/listed" ]; then
+      echo '[{"id":"hq-cv-1","title":"One"},{"id":"hq-cv-2","title":"Two"}]'
+    else
+      touch "` + dir + `/listed"
+      echo '[{"id":"hq-cv-1","title":"One"}]'
+    fi
+    ;;
+  show)
+    echo '[{"id":"gt-1","title":"Done","status":"closed"}]'
+    ;;
+  close)
+    echo "close $2" >> "` + logPath + `"
+    ;;
+esac
+exit 0
+`
+	sqliteScript := `#!/bin/sh
+echo '[{"depends_on_id":"gt-1","type":"tracks"}]'
+`
+	for name, script := range map[string]string{"bd": bdScript, "sqlite3": sqliteScript} {
+		if err := os.WriteFile(filepath.Join(binDir, name), []byte(script), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	var buf bytes.Buffer
+	oldOut := cleanupOut
+	cleanupOut = &buf
+	defer func() { cleanupOut = oldOut }()
+
+	exportPath := filepath.Join(dir, "convoys.json")
+	audit := &cleanupAuditEntry{}
+	n, err := cleanupCompletedConvoys(context.Background(), townBeads, false, nil, exportPath, &cleanupWarnings{}, audit)
+	if err != nil {
+		t.Fatalf("cleanupCompletedConvoys: %v", err)
+	}
+	if n != 1 {
+		t.Errorf("closed %d convoys, want 1", n)
+	}
+
+	log, err := os.ReadFile(logPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.TrimSpace(string(log)); got != "close hq-cv-1" {
+		t.Errorf("bd closes = %q, want only hq-cv-1", got)
+	}
+	if len(audit.Actions) != 1 || audit.Actions[0].ID != "hq-cv-1" {
+		t.Errorf("audit = %+v, want hq-cv-1 only", audit.Actions)
+	}
+}
+
+// TestCleanupConvoyCloseFailureIsAWarning checks that a convoy bd refuses
+// to close is reported through the cleanup warnings, not printed to stdout
+// where it would corrupt --json output.
+func TestCleanupConvoyCloseFailureIsAWarning(t *testing.T) {
+	dir := t.TempDir()
+	townBeads := filepath.Join(dir, ".beads")
+	if err := os.MkdirAll(townBeads, 0755); err != nil {
+		t.Fatal(err)
+	}
+	binDir := filepath.Join(dir, "bin")
+	if err := os.MkdirAll(binDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	bdScript := `#!/bin/sh
+[ "$1" = "--no-daemon" ] && shift
+case "$1" in
+  list)
+    echo '[{"id":"hq-cv-1","title":"One"}]'
+    ;;
+  show)
+    echo '[{"id":"gt-1","title":"Done","status":"closed"}]'
+    ;;
+  close)
+    exit 1
+    ;;
+esac
+exit 0
+`
+	sqliteScript := `#!/bin/sh
+echo '[{"depends_on_id":"gt-1","type":"tracks"}]'
+`
+	for name, script := range map[string]string{"bd": bdScript, "sqlite3": sqliteScript} {
+		if err := os.WriteFile(filepath.Join(binDir, name), []byte(script), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	var buf bytes.Buffer
+	oldOut := cleanupOut
+	cleanupOut = &buf
+	defer func() { cleanupOut = oldOut }()
+
+	warnings := &cleanupWarnings{}
+	var n int
+	var err error
+	stdout := captureStdout(t, func() {
+		n, err = cleanupCompletedConvoys(context.Background(), townBeads, false, nil, "", warnings, &cleanupAuditEntry{})
+	})
+	if err != nil {
+		t.Fatalf("cleanupCompletedConvoys: %v", err)
+	}
+	if n != 0 {
+		t.Errorf("closed %d convoys, want 0", n)
+	}
+	if stdout != "" {
+		t.Errorf("stdout = %q, want nothing", stdout)
+	}
+	if len(warnings.items) != 1 || !strings.Contains(warnings.items[0].Message, "couldn't close convoy hq-cv-1") {
+		t.Errorf("warnings = %+v, want the failed close of hq-cv-1", warnings.items)
+	}
+}
+
+func TestStopPolecatSessionRefusesAmbiguousSession(t *testing.T) {
+	// Rig "a" polecat "b-c" and rig "a-b" polecat "c" are both gt-a-b-c
+	townRoot := t.TempDir()
+	for _, dir := range []string{"a/polecats/b-c", "a-b/polecats/c"} {
+		if err := os.MkdirAll(filepath.Join(townRoot, dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeFile := func(rel, content string) {
+		if err := os.WriteFile(filepath.Join(townRoot, rel), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.MkdirAll(filepath.Join(townRoot, "mayor"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	writeFile("mayor/town.json", `{"type": "town", "version": 1, "name": "test"}`)
+	writeFile("mayor/rigs.json", `{"version": 1, "rigs": {"a": {}, "a-b": {}}}`)
+
+	r := &rig.Rig{Name: "a", Path: filepath.Join(townRoot, "a")}
+	err := stopPolecatSession(tmux.NewTmux(), r, "b-c")
+	if !errors.Is(err, polecat.ErrSessionAmbiguous) {
+		t.Fatalf("stopPolecatSession(b-c) = %v, want ErrSessionAmbiguous", err)
+	}
+
+	if err := reapPolecat(tmux.NewTmux(), r, nil, "b-c", "test"); !errors.Is(err, polecat.ErrSessionAmbiguous) {
+		t.Errorf("reapPolecat(b-c) = %v, want ErrSessionAmbiguous", err)
+	}
+	if _, err := os.Stat(filepath.Join(townRoot, "a", "polecats", "b-c")); err != nil {
+		t.Errorf("polecat with an ambiguous session was removed: %v", err)
+	}
+}
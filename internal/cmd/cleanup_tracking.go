@@ -0,0 +1,172 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// CleanupTrackingFix is an open convoy whose tracking links were stale and
+// reconciled by --recompute-tracking.
+type CleanupTrackingFix struct {
+	ID         string             `json:"id"`
+	Title      string             `json:"title"`
+	Relinked   []CleanupTrackLink `json:"relinked,omitempty"`   // Links re-pointed at the issue's current ID
+	Removed    []string           `json:"removed,omitempty"`    // Duplicate links to an already-tracked issue
+	Unresolved []string           `json:"unresolved,omitempty"` // Links bd can't resolve; left in place
+	Applied    bool               `json:"applied"`              // The links were changed (not a dry run, no errors)
+}
+
+// CleanupTrackLink is a tracking link replaced by its canonical form.
+type CleanupTrackLink struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// changed reports whether the convoy's links need any change.
+func (f CleanupTrackingFix) changed() bool {
+	return len(f.Relinked) > 0 || len(f.Removed) > 0
+}
+
+// planTrackingFix derives a convoy's tracked issues from its raw tracking
+// links. resolve returns an issue's current ID as bd reports it, or "" if it
+// can't be found. A link whose canonical form (formatTrackBeadID of the
+// current ID) differs - the issue was moved or renamed, or the link predates
+// the external: format - is relinked; a second link to the same issue is
+// removed. Links already in canonical form are kept in preference to stale
+// ones, and unresolvable links are only reported, since bd may simply be
+// unable to reach their rig.
+func planTrackingFix(links []string, resolve func(id string) string) CleanupTrackingFix {
+	var fix CleanupTrackingFix
+	want := make([]string, len(links))
+	tracked := make(map[string]bool)
+	for i, link := range links {
+		if id := resolve(trackedLinkID(link)); id != "" {
+			want[i] = formatTrackBeadID(id)
+			if want[i] == link {
+				tracked[link] = true
+			}
+		}
+	}
+
+	kept := make(map[string]bool)
+	for i, link := range links {
+		switch {
+		case want[i] == "":
+			fix.Unresolved = append(fix.Unresolved, link)
+		case want[i] == link && !kept[link]:
+			kept[link] = true
+		case tracked[want[i]] || kept[want[i]]:
+			fix.Removed = append(fix.Removed, link)
+		default:
+			kept[want[i]] = true
+			fix.Relinked = append(fix.Relinked, CleanupTrackLink{From: link, To: want[i]})
+		}
+	}
+	return fix
+}
+
+// recomputeConvoyTracking reconciles the tracking links of every open convoy
+// with the issues they resolve to (--recompute-tracking), so the closure
+// check that follows sees each tracked issue under its current ID. The new
+// link is added before the stale one is removed, so a failure never leaves
+// an issue untracked. Returns the convoys whose tracking was (or, in a dry
+// run, would be) corrected.
+func recomputeConvoyTracking(ctx context.Context, townBeads string, dryRun bool) ([]CleanupTrackingFix, error) {
+	convoys, err := listOpenConvoys(ctx, townBeads)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := make(map[string]string)
+	resolve := func(id string) string {
+		if cur, ok := resolved[id]; ok {
+			return cur
+		}
+		if details := getIssueDetails(id); details != nil {
+			resolved[id] = details.ID
+		} else {
+			resolved[id] = ""
+		}
+		return resolved[id]
+	}
+
+	var fixes []CleanupTrackingFix
+	var failed int
+	for _, convoy := range convoys {
+		if err := ctx.Err(); err != nil {
+			return fixes, err
+		}
+		links, err := getTrackingLinks(townBeads, convoy.ID)
+		if err != nil {
+			fmt.Fprintf(cleanupOut, "  %s %v\n", style.Warning.Render("⚠"), err)
+			failed++
+			continue
+		}
+		fix := planTrackingFix(links, resolve)
+		if !fix.changed() {
+			continue
+		}
+		fix.ID, fix.Title = convoy.ID, convoy.Title
+
+		fmt.Fprintf(cleanupOut, "  Stale tracking: %s (%s)\n", fix.ID, fix.Title)
+		verb := "Relinked"
+		if dryRun {
+			verb = "Would relink"
+		}
+		ok := true
+		for _, l := range fix.Relinked {
+			if !dryRun {
+				if err := runTrackingDep(townBeads, "add", fix.ID, l.To, "--type=tracks"); err != nil {
+					ok = false
+					fmt.Fprintf(cleanupOut, "    %s Failed to relink %s: %v\n", style.Error.Render("✗"), l.From, err)
+					continue
+				}
+				if err := runTrackingDep(townBeads, "remove", fix.ID, l.From); err != nil {
+					ok = false
+					fmt.Fprintf(cleanupOut, "    %s Failed to unlink %s: %v\n", style.Error.Render("✗"), l.From, err)
+					continue
+				}
+			}
+			fmt.Fprintf(cleanupOut, "    %s %s → %s\n", verb, l.From, l.To)
+		}
+		verb = "Removed duplicate link"
+		if dryRun {
+			verb = "Would remove duplicate link"
+		}
+		for _, link := range fix.Removed {
+			if !dryRun {
+				if err := runTrackingDep(townBeads, "remove", fix.ID, link); err != nil {
+					ok = false
+					fmt.Fprintf(cleanupOut, "    %s Failed to unlink %s: %v\n", style.Error.Render("✗"), link, err)
+					continue
+				}
+			}
+			fmt.Fprintf(cleanupOut, "    %s %s\n", verb, link)
+		}
+		for _, link := range fix.Unresolved {
+			fmt.Fprintf(cleanupOut, "    %s\n", style.Dim.Render("Left unresolvable link "+link))
+		}
+		if !ok {
+			failed++
+		}
+		fix.Applied = ok && !dryRun
+		fixes = append(fixes, fix)
+	}
+	if failed > 0 {
+		return fixes, fmt.Errorf("%s could not be reconciled", style.Count(failed, "convoy", "convoys"))
+	}
+	return fixes, nil
+}
+
+// runTrackingDep runs 'bd dep <verb> <args>' against the town beads.
+func runTrackingDep(townBeads, verb string, args ...string) error {
+	depCmd := exec.Command("bd", append([]string{"dep", verb}, args...)...)
+	depCmd.Dir = townBeads
+	if out, err := depCmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%w %s", err, string(out))
+	}
+	return nil
+}
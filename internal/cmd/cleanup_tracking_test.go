@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPlanTrackingFix(t *testing.T) {
+	// gt-abc-old was moved to gt-xyz-new; gt-abc-gone no longer resolves
+	current := map[string]string{
+		"gt-abc-old": "gt-xyz-new",
+		"gt-xyz-new": "gt-xyz-new",
+		"gt-abc-ok":  "gt-abc-ok",
+		"hq-task":    "hq-task",
+	}
+	resolve := func(id string) string { return current[id] }
+
+	links := []string{
+		"external:gt-abc:gt-abc-old", // Moved: relinked
+		"external:gt-abc:gt-abc-ok",  // Canonical: kept
+		"gt-abc-ok",                  // Duplicate of the canonical link: removed
+		"hq-task",                    // Canonical for hq- IDs: kept
+		"external:gt-abc:gt-abc-gone",
+	}
+	fix := planTrackingFix(links, resolve)
+
+	wantRelinked := []CleanupTrackLink{{From: "external:gt-abc:gt-abc-old", To: "external:gt-xyz:gt-xyz-new"}}
+	if !reflect.DeepEqual(fix.Relinked, wantRelinked) {
+		t.Errorf("Relinked = %+v, want %+v", fix.Relinked, wantRelinked)
+	}
+	if want := []string{"gt-abc-ok"}; !reflect.DeepEqual(fix.Removed, want) {
+		t.Errorf("Removed = %v, want %v", fix.Removed, want)
+	}
+	if want := []string{"external:gt-abc:gt-abc-gone"}; !reflect.DeepEqual(fix.Unresolved, want) {
+		t.Errorf("Unresolved = %v, want %v", fix.Unresolved, want)
+	}
+	if !fix.changed() {
+		t.Error("changed() = false, want true")
+	}
+}
+
+func TestPlanTrackingFixPrefersCanonicalLink(t *testing.T) {
+	resolve := func(id string) string { return "gt-xyz-new" }
+
+	// The stale link comes first, but the existing canonical link is kept
+	// rather than re-added and then removed.
+	links := []string{"external:gt-abc:gt-abc-old", "external:gt-xyz:gt-xyz-new"}
+	fix := planTrackingFix(links, resolve)
+	if len(fix.Relinked) != 0 {
+		t.Errorf("Relinked = %+v, want none", fix.Relinked)
+	}
+	if want := []string{"external:gt-abc:gt-abc-old"}; !reflect.DeepEqual(fix.Removed, want) {
+		t.Errorf("Removed = %v, want %v", fix.Removed, want)
+	}
+}
+
+func TestPlanTrackingFixUpToDate(t *testing.T) {
+	resolve := func(id string) string { return id }
+	fix := planTrackingFix([]string{"external:gt-abc:gt-abc-1", "hq-2"}, resolve)
+	if fix.changed() || len(fix.Unresolved) != 0 {
+		t.Errorf("fix = %+v, want no changes", fix)
+	}
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"bytes"
+	"context"
 	"crypto/rand"
 	"encoding/base32"
 	"encoding/json"
@@ -9,6 +10,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
 	"strconv"
 	"strings"
 	"sync"
@@ -62,11 +64,15 @@ func looksLikeIssueID(s string) bool {
 var (
 	convoyMolecule     string
 	convoyNotify       string
+	convoyTitle        string
+	convoyTrack        []string
+	convoyCreateDryRun bool
 	convoyStatusJSON   bool
 	convoyListJSON     bool
 	convoyListStatus   string
 	convoyListAll      bool
 	convoyListTree     bool
+	convoyListClosedBy string
 	convoyInteractive  bool
 	convoyStrandedJSON bool
 )
@@ -116,14 +122,23 @@ var convoyCreateCmd = &cobra.Command{
 	Long: `Create a new convoy that tracks the specified issues.
 
 The convoy is created in town-level beads (hq-* prefix) and can track
-issues across any rig.
+issues across any rig. Issues can be given as arguments or with --track;
+with --title, all arguments are issues. Every tracked issue must exist, or
+nothing is created. --dry-run prints the bd commands instead of running them.
 
 Examples:
   gt convoy create "Deploy v2.0" gt-abc bd-xyz
+  gt convoy create --title "Deploy v2.0" --track gt-abc,bd-xyz
+  gt convoy create --title "Deploy v2.0" --track gt-abc --dry-run
   gt convoy create "Release prep" gt-abc --notify           # defaults to mayor/
   gt convoy create "Release prep" gt-abc --notify ops/      # notify ops/
   gt convoy create "Feature rollout" gt-a gt-b gt-c --molecule mol-release`,
-	Args: cobra.MinimumNArgs(1),
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 && convoyTitle == "" {
+			return fmt.Errorf("requires a convoy name (or --title)")
+		}
+		return nil
+	},
 	RunE: runConvoyCreate,
 }
 
@@ -148,7 +163,13 @@ Examples:
   gt convoy list --all        # All convoys (open + closed)
   gt convoy list --status=closed  # Recently landed
   gt convoy list --tree       # Show convoy + child status tree
-  gt convoy list --json`,
+  gt convoy list --closed-by="gt cleanup"  # Closed by automated sweeps
+  gt convoy list --closed-by=manual        # Closed by hand with bd
+  gt convoy list --json
+
+Convoys closed by gt (cleanup, convoy check) record the closing actor and
+command in their close reason. --closed-by matches either one; "me" is the
+current actor, and "manual" selects convoys closed without such a record.`,
 	RunE: runConvoyList,
 }
 
@@ -204,6 +225,9 @@ func init() {
 	convoyCreateCmd.Flags().StringVar(&convoyMolecule, "molecule", "", "Associated molecule ID")
 	convoyCreateCmd.Flags().StringVar(&convoyNotify, "notify", "", "Address to notify on completion (default: mayor/ if flag used without value)")
 	convoyCreateCmd.Flags().Lookup("notify").NoOptDefVal = "mayor/"
+	convoyCreateCmd.Flags().StringVar(&convoyTitle, "title", "", "Convoy title (all arguments are then issue IDs)")
+	convoyCreateCmd.Flags().StringSliceVar(&convoyTrack, "track", nil, "Issue IDs to track (comma-separated or repeated)")
+	convoyCreateCmd.Flags().BoolVar(&convoyCreateDryRun, "dry-run", false, "Print the bd commands without running them")
 
 	// Status flags
 	convoyStatusCmd.Flags().BoolVar(&convoyStatusJSON, "json", false, "Output as JSON")
@@ -213,6 +237,7 @@ func init() {
 	convoyListCmd.Flags().StringVar(&convoyListStatus, "status", "", "Filter by status (open, closed)")
 	convoyListCmd.Flags().BoolVar(&convoyListAll, "all", false, "Show all convoys (open and closed)")
 	convoyListCmd.Flags().BoolVar(&convoyListTree, "tree", false, "Show convoy + child status tree")
+	convoyListCmd.Flags().StringVar(&convoyListClosedBy, "closed-by", "", "Only closed convoys closed by this actor or command (\"me\", \"gt cleanup\", \"manual\")")
 
 	// Interactive TUI flag (on parent command)
 	convoyCmd.Flags().BoolVarP(&convoyInteractive, "interactive", "i", false, "Interactive tree view")
@@ -241,12 +266,19 @@ func getTownBeadsDir() (string, error) {
 }
 
 func runConvoyCreate(cmd *cobra.Command, args []string) error {
-	name := args[0]
-	trackedIssues := args[1:]
+	var name string
+	var trackedIssues []string
+	if convoyTitle != "" {
+		name = convoyTitle
+		trackedIssues = args
+	} else {
+		name = args[0]
+		trackedIssues = args[1:]
+	}
 
 	// If first arg looks like an issue ID (has beads prefix), treat all args as issues
 	// and auto-generate a name from the first issue's title
-	if looksLikeIssueID(name) {
+	if convoyTitle == "" && looksLikeIssueID(name) {
 		trackedIssues = args // All args are issue IDs
 		// Get the first issue's title to use as convoy name
 		if details := getIssueDetails(args[0]); details != nil && details.Title != "" {
@@ -256,6 +288,11 @@ func runConvoyCreate(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	trackedIssues = uniqueIssueIDs(append(trackedIssues, convoyTrack...))
+	if err := validateTrackedIssues(trackedIssues); err != nil {
+		return err
+	}
+
 	townBeads, err := getTownBeadsDir()
 	if err != nil {
 		return err
@@ -282,6 +319,15 @@ func runConvoyCreate(cmd *cobra.Command, args []string) error {
 		"--json",
 	}
 
+	if convoyCreateDryRun {
+		fmt.Printf("Would create convoy %s (%s) in %s:\n", convoyID, name, townBeads)
+		fmt.Printf("  %s\n", formatBdCommand(createArgs))
+		for _, issueID := range trackedIssues {
+			fmt.Printf("  %s\n", formatBdCommand([]string{"dep", "add", convoyID, issueID, "--type=tracks"}))
+		}
+		return nil
+	}
+
 	createCmd := exec.Command("bd", createArgs...)
 	createCmd.Dir = townBeads
 	var stdout bytes.Buffer
@@ -329,6 +375,51 @@ func runConvoyCreate(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// uniqueIssueIDs drops empty and repeated IDs, keeping first-seen order.
+func uniqueIssueIDs(ids []string) []string {
+	seen := make(map[string]bool, len(ids))
+	var unique []string
+	for _, id := range ids {
+		id = strings.TrimSpace(id)
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		unique = append(unique, id)
+	}
+	return unique
+}
+
+// validateTrackedIssues checks that every issue a new convoy will track
+// exists, so the convoy's 'tracks' relations all resolve.
+func validateTrackedIssues(ids []string) error {
+	details := getIssueDetailsBatch(ids)
+	var missing []string
+	for _, id := range ids {
+		if details[id] == nil {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%s not found: %s", style.Count(len(missing), "tracked issue", "tracked issues"),
+			strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// formatBdCommand renders a bd invocation for copy-paste, quoting arguments
+// that the shell would split or interpret.
+func formatBdCommand(args []string) string {
+	parts := []string{"bd"}
+	for _, arg := range args {
+		if arg == "" || strings.ContainsAny(arg, " \t\n\"'$`\\|&;<>()*?!#") {
+			arg = "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+		}
+		parts = append(parts, arg)
+	}
+	return strings.Join(parts, " ")
+}
+
 func runConvoyAdd(cmd *cobra.Command, args []string) error {
 	convoyID := args[0]
 	issuesToAdd := args[1:]
@@ -415,7 +506,10 @@ func runConvoyCheck(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	closed, err := checkAndCloseCompletedConvoys(townBeads)
+	closed, skipped, err := checkAndCloseCompletedConvoys(cmd.Context(), townBeads, "gt convoy check")
+	for _, reason := range skipped {
+		style.PrintWarning("%v", reason)
+	}
 	if err != nil {
 		return err
 	}
@@ -423,7 +517,7 @@ func runConvoyCheck(cmd *cobra.Command, args []string) error {
 	if len(closed) == 0 {
 		fmt.Println("No convoys ready to close.")
 	} else {
-		fmt.Printf("%s Auto-closed %d convoy(s):\n", style.Bold.Render("✓"), len(closed))
+		fmt.Printf("%s Auto-closed %s:\n", style.Bold.Render("✓"), style.Count(len(closed), "convoy", "convoys"))
 		for _, c := range closed {
 			fmt.Printf("  🚚 %s: %s\n", c.ID, c.Title)
 		}
@@ -469,7 +563,7 @@ func runConvoyStranded(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	fmt.Printf("%s Found %d stranded convoy(s):\n\n", style.Warning.Render("⚠"), len(stranded))
+	fmt.Printf("%s Found %s:\n\n", style.Warning.Render("⚠"), style.Count(len(stranded), "stranded convoy", "stranded convoys"))
 	for _, s := range stranded {
 		fmt.Printf("  🚚 %s: %s\n", s.ID, s.Title)
 		fmt.Printf("     Ready issues: %d\n", s.ReadyCount)
@@ -606,19 +700,24 @@ func isReadyIssue(t trackedIssueInfo, blockedIssues map[string]bool) bool {
 }
 
 // checkAndCloseCompletedConvoys finds open convoys where all tracked issues are closed
-// and auto-closes them. Returns the list of convoys that were closed.
-func checkAndCloseCompletedConvoys(townBeads string) ([]struct{ ID, Title string }, error) {
-	var closed []struct{ ID, Title string }
+// and auto-closes them. Returns the list of convoys that were closed, and
+// why each complete convoy that wasn't closed was skipped; the caller
+// decides how to report those.
+// Each convoy's tracked issues are re-verified immediately before its close;
+// a convoy that changed in between is skipped rather than closed.
+// If ctx is cancelled, the convoy being closed is finished and the rest are
+// skipped; the convoys closed so far are returned with ctx.Err().
+func checkAndCloseCompletedConvoys(ctx context.Context, townBeads, closer string) (closed []struct{ ID, Title string }, skipped []error, err error) {
 
 	// List all open convoys
 	listArgs := []string{"list", "--type=convoy", "--status=open", "--json"}
-	listCmd := exec.Command("bd", listArgs...)
+	listCmd := exec.CommandContext(ctx, "bd", listArgs...)
 	listCmd.Dir = townBeads
 	var stdout bytes.Buffer
 	listCmd.Stdout = &stdout
 
 	if err := listCmd.Run(); err != nil {
-		return nil, fmt.Errorf("listing convoys: %w", err)
+		return nil, nil, fmt.Errorf("listing convoys: %w", err)
 	}
 
 	var convoys []struct {
@@ -626,44 +725,113 @@ func checkAndCloseCompletedConvoys(townBeads string) ([]struct{ ID, Title string
 		Title string `json:"title"`
 	}
 	if err := json.Unmarshal(stdout.Bytes(), &convoys); err != nil {
-		return nil, fmt.Errorf("parsing convoy list: %w", err)
+		return nil, nil, fmt.Errorf("parsing convoy list: %w", err)
 	}
 
 	// Check each convoy
 	for _, convoy := range convoys {
+		if err := ctx.Err(); err != nil {
+			return closed, skipped, err
+		}
 		tracked := getTrackedIssues(townBeads, convoy.ID)
-		if len(tracked) == 0 {
-			continue // No tracked issues, nothing to check
+		if len(tracked) == 0 || !trackedIssuesComplete(tracked) {
+			continue // Nothing tracked, or still in progress
 		}
 
-		// Check if all tracked issues are closed
-		allClosed := true
-		for _, t := range tracked {
-			if t.Status != "closed" && t.Status != "tombstone" {
-				allClosed = false
-				break
-			}
+		if err := closeCompletedConvoy(townBeads, convoy.ID, convoy.Title, tracked, closer); err != nil {
+			skipped = append(skipped, err)
+			continue
 		}
+		closed = append(closed, struct{ ID, Title string }{convoy.ID, convoy.Title})
+	}
 
-		if allClosed {
-			// Close the convoy
-			closeArgs := []string{"close", convoy.ID, "-r", "All tracked issues completed"}
-			closeCmd := exec.Command("bd", closeArgs...)
-			closeCmd.Dir = townBeads
+	return closed, skipped, nil
+}
 
-			if err := closeCmd.Run(); err != nil {
-				style.PrintWarning("couldn't close convoy %s: %v", convoy.ID, err)
-				continue
-			}
+// closeCompletedConvoy closes a convoy whose tracked issues were seen as
+// complete and notifies its subscribers. The error says why the convoy was
+// left open; it is nil once the convoy is closed.
+func closeCompletedConvoy(townBeads, convoyID, title string, tracked []trackedIssueInfo, closer string) error {
+	// bd has no conditional close, so re-read the tracked issues right
+	// before closing. An issue reopened or newly tracked since tracked was
+	// read means the convoy is no longer complete.
+	if recheck := getTrackedIssues(townBeads, convoyID); !trackedIssuesUnchanged(tracked, recheck) {
+		return fmt.Errorf("convoy %s changed while closing; skipping", convoyID)
+	}
+
+	closeArgs := []string{"close", convoyID, "-r", convoyCloseReason("All tracked issues completed", closer)}
+	closeCmd := exec.Command("bd", closeArgs...)
+	closeCmd.Dir = townBeads
+
+	if err := closeCmd.Run(); err != nil {
+		return fmt.Errorf("couldn't close convoy %s: %w", convoyID, err)
+	}
+
+	// Check if convoy has notify address and send notification
+	notifyConvoyCompletion(townBeads, convoyID, title)
+	return nil
+}
 
-			closed = append(closed, struct{ ID, Title string }{convoy.ID, convoy.Title})
+// convoyCloseReason appends who closed a convoy to its close reason, e.g.
+// "All tracked issues completed [closed-by: mayor/ via gt cleanup]", so
+// audits can tell automated closes from manual ones. closer is the gt
+// command doing the close; the actor is the current sender identity.
+func convoyCloseReason(reason, closer string) string {
+	return fmt.Sprintf("%s [closed-by: %s via %s]", reason, detectSender(), closer)
+}
+
+var convoyClosedByRe = regexp.MustCompile(`\[closed-by: (.*) via (.*)\]$`)
+
+// convoyClosedBy extracts the actor and command recorded by
+// convoyCloseReason. ok is false for convoys closed without a record.
+func convoyClosedBy(closeReason string) (actor, via string, ok bool) {
+	m := convoyClosedByRe.FindStringSubmatch(closeReason)
+	if m == nil {
+		return "", "", false
+	}
+	return m[1], m[2], true
+}
+
+// convoyClosedByMatches applies a --closed-by filter to a close reason:
+// "manual" matches convoys with no closed-by record, "me" matches the actor
+// me, and anything else matches the recorded actor or command.
+func convoyClosedByMatches(closeReason, filter, me string) bool {
+	actor, via, ok := convoyClosedBy(closeReason)
+	switch filter {
+	case "manual":
+		return !ok
+	case "me":
+		filter = me
+	}
+	return ok && (strings.EqualFold(actor, filter) || strings.EqualFold(via, filter))
+}
 
-			// Check if convoy has notify address and send notification
-			notifyConvoyCompletion(townBeads, convoy.ID, convoy.Title)
+// trackedIssuesComplete reports whether every tracked issue is closed.
+func trackedIssuesComplete(tracked []trackedIssueInfo) bool {
+	for _, t := range tracked {
+		if t.Status != "closed" && t.Status != "tombstone" {
+			return false
 		}
 	}
+	return true
+}
 
-	return closed, nil
+// trackedIssuesUnchanged reports whether a re-read of a convoy's tracked
+// issues still matches the complete set seen earlier: same issues, all closed.
+func trackedIssuesUnchanged(before, after []trackedIssueInfo) bool {
+	if len(after) != len(before) || !trackedIssuesComplete(after) {
+		return false
+	}
+	seen := make(map[string]bool, len(before))
+	for _, t := range before {
+		seen[t.ID] = true
+	}
+	for _, t := range after {
+		if !seen[t.ID] {
+			return false
+		}
+	}
+	return true
 }
 
 // notifyConvoyCompletion sends a notification if the convoy has a notify address.
@@ -896,6 +1064,8 @@ func runConvoyList(cmd *cobra.Command, args []string) error {
 	listArgs := []string{"list", "--type=convoy", "--json"}
 	if convoyListStatus != "" {
 		listArgs = append(listArgs, "--status="+convoyListStatus)
+	} else if convoyListClosedBy != "" {
+		listArgs = append(listArgs, "--status=closed")
 	} else if convoyListAll {
 		listArgs = append(listArgs, "--all")
 	}
@@ -911,15 +1081,27 @@ func runConvoyList(cmd *cobra.Command, args []string) error {
 	}
 
 	var convoys []struct {
-		ID        string `json:"id"`
-		Title     string `json:"title"`
-		Status    string `json:"status"`
-		CreatedAt string `json:"created_at"`
+		ID          string `json:"id"`
+		Title       string `json:"title"`
+		Status      string `json:"status"`
+		CreatedAt   string `json:"created_at"`
+		CloseReason string `json:"close_reason,omitempty"`
 	}
 	if err := json.Unmarshal(stdout.Bytes(), &convoys); err != nil {
 		return fmt.Errorf("parsing convoy list: %w", err)
 	}
 
+	if convoyListClosedBy != "" {
+		me := detectSender()
+		filtered := convoys[:0]
+		for _, c := range convoys {
+			if c.Status == "closed" && convoyClosedByMatches(c.CloseReason, convoyListClosedBy, me) {
+				filtered = append(filtered, c)
+			}
+		}
+		convoys = filtered
+	}
+
 	if convoyListJSON {
 		enc := json.NewEncoder(os.Stdout)
 		enc.SetIndent("", "  ")
@@ -940,7 +1122,11 @@ func runConvoyList(cmd *cobra.Command, args []string) error {
 	fmt.Printf("%s\n\n", style.Bold.Render("Convoys"))
 	for i, c := range convoys {
 		status := formatConvoyStatus(c.Status)
-		fmt.Printf("  %d. 🚚 %s: %s %s\n", i+1, c.ID, c.Title, status)
+		line := fmt.Sprintf("  %d. 🚚 %s: %s %s", i+1, c.ID, c.Title, status)
+		if created, err := time.Parse(time.RFC3339, c.CreatedAt); err == nil {
+			line += " " + style.Dim.Render("created "+style.HumanizeTime(created))
+		}
+		fmt.Println(line)
 	}
 	fmt.Printf("\nUse 'gt convoy status <id>' or 'gt convoy status <n>' for detailed view.\n")
 
@@ -949,10 +1135,11 @@ func runConvoyList(cmd *cobra.Command, args []string) error {
 
 // printConvoyTree displays convoys with their child issues in a tree format.
 func printConvoyTree(townBeads string, convoys []struct {
-	ID        string `json:"id"`
-	Title     string `json:"title"`
-	Status    string `json:"status"`
-	CreatedAt string `json:"created_at"`
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	Status      string `json:"status"`
+	CreatedAt   string `json:"created_at"`
+	CloseReason string `json:"close_reason,omitempty"`
 }) error {
 	for _, c := range convoys {
 		// Get tracked issues for this convoy
@@ -1031,44 +1218,18 @@ type trackedIssueInfo struct {
 // This is needed because bd dep list doesn't properly show cross-rig external dependencies.
 // Uses batched lookup to avoid N+1 subprocess calls.
 func getTrackedIssues(townBeads, convoyID string) []trackedIssueInfo {
-	dbPath := filepath.Join(townBeads, "beads.db")
-
-	// Query tracked dependencies from SQLite
-	// Escape single quotes to prevent SQL injection
-	safeConvoyID := strings.ReplaceAll(convoyID, "'", "''")
-	queryCmd := exec.Command("sqlite3", "-json", dbPath,
-		fmt.Sprintf(`SELECT depends_on_id, type FROM dependencies WHERE issue_id = '%s' AND type = 'tracks'`, safeConvoyID))
-
-	var stdout bytes.Buffer
-	queryCmd.Stdout = &stdout
-	if err := queryCmd.Run(); err != nil {
-		return nil
-	}
-
-	var deps []struct {
-		DependsOnID string `json:"depends_on_id"`
-		Type        string `json:"type"`
-	}
-	if err := json.Unmarshal(stdout.Bytes(), &deps); err != nil {
+	links, err := getTrackingLinks(townBeads, convoyID)
+	if err != nil {
 		return nil
 	}
 
 	// First pass: collect all issue IDs (normalized from external refs)
-	issueIDs := make([]string, 0, len(deps))
+	issueIDs := make([]string, 0, len(links))
 	idToDepType := make(map[string]string)
-	for _, dep := range deps {
-		issueID := dep.DependsOnID
-
-		// Handle external reference format: external:rig:issue-id
-		if strings.HasPrefix(issueID, "external:") {
-			parts := strings.SplitN(issueID, ":", 3)
-			if len(parts) == 3 {
-				issueID = parts[2] // Extract the actual issue ID
-			}
-		}
-
+	for _, link := range links {
+		issueID := trackedLinkID(link)
 		issueIDs = append(issueIDs, issueID)
-		idToDepType[issueID] = dep.Type
+		idToDepType[issueID] = "tracks"
 	}
 
 	// Single batch call to get all issue details
@@ -1113,6 +1274,51 @@ func getTrackedIssues(townBeads, convoyID string) []trackedIssueInfo {
 	return tracked
 }
 
+// getTrackingLinks returns the raw targets of convoyID's "tracks"
+// dependencies, as stored (external refs are not normalized).
+func getTrackingLinks(townBeads, convoyID string) ([]string, error) {
+	dbPath := filepath.Join(townBeads, "beads.db")
+
+	// Query tracked dependencies from SQLite
+	// Escape single quotes to prevent SQL injection
+	safeConvoyID := strings.ReplaceAll(convoyID, "'", "''")
+	queryCmd := exec.Command("sqlite3", "-json", dbPath,
+		fmt.Sprintf(`SELECT depends_on_id FROM dependencies WHERE issue_id = '%s' AND type = 'tracks'`, safeConvoyID))
+
+	var stdout bytes.Buffer
+	queryCmd.Stdout = &stdout
+	if err := queryCmd.Run(); err != nil {
+		return nil, fmt.Errorf("querying tracked issues of %s: %w", convoyID, err)
+	}
+	if len(bytes.TrimSpace(stdout.Bytes())) == 0 {
+		return nil, nil // sqlite3 prints nothing for an empty result
+	}
+
+	var deps []struct {
+		DependsOnID string `json:"depends_on_id"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &deps); err != nil {
+		return nil, fmt.Errorf("parsing tracked issues of %s: %w", convoyID, err)
+	}
+	links := make([]string, 0, len(deps))
+	for _, dep := range deps {
+		links = append(links, dep.DependsOnID)
+	}
+	return links, nil
+}
+
+// trackedLinkID returns the issue ID a tracking link points at, stripping
+// the external reference format (external:rig:issue-id).
+func trackedLinkID(link string) string {
+	if strings.HasPrefix(link, "external:") {
+		parts := strings.SplitN(link, ":", 3)
+		if len(parts) == 3 {
+			return parts[2]
+		}
+	}
+	return link
+}
+
 // issueDetails holds basic issue info.
 type issueDetails struct {
 	ID        string
@@ -1316,7 +1522,7 @@ func getWorkersForIssues(issueIDs []string) map[string]*workerInfo {
 			age := ""
 			if agent.LastActivity != "" {
 				if t, err := time.Parse(time.RFC3339, agent.LastActivity); err == nil {
-					age = formatWorkerAge(time.Since(t))
+					age = style.HumanizeDuration(time.Since(t))
 				}
 			}
 
@@ -1347,20 +1553,6 @@ func parseWorkerFromAgentBead(agentID string) string {
 	return strings.Join(parts, "/")
 }
 
-// formatWorkerAge formats a duration as a short string (e.g., "5m", "2h", "1d")
-func formatWorkerAge(d time.Duration) string {
-	if d < time.Minute {
-		return "<1m"
-	}
-	if d < time.Hour {
-		return fmt.Sprintf("%dm", int(d.Minutes()))
-	}
-	if d < 24*time.Hour {
-		return fmt.Sprintf("%dh", int(d.Hours()))
-	}
-	return fmt.Sprintf("%dd", int(d.Hours()/24))
-}
-
 // runConvoyTUI launches the interactive convoy TUI.
 func runConvoyTUI() error {
 	townBeads, err := getTownBeadsDir()
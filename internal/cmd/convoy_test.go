@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTrackedIssuesUnchanged(t *testing.T) {
+	before := []trackedIssueInfo{
+		{ID: "gt-a", Status: "closed"},
+		{ID: "gt-b", Status: "tombstone"},
+	}
+
+	tests := []struct {
+		name  string
+		after []trackedIssueInfo
+		want  bool
+	}{
+		{"same", []trackedIssueInfo{{ID: "gt-b", Status: "tombstone"}, {ID: "gt-a", Status: "closed"}}, true},
+		{"reopened", []trackedIssueInfo{{ID: "gt-a", Status: "open"}, {ID: "gt-b", Status: "tombstone"}}, false},
+		{"newly tracked", append(append([]trackedIssueInfo{}, before...), trackedIssueInfo{ID: "gt-c", Status: "closed"}), false},
+		{"swapped", []trackedIssueInfo{{ID: "gt-a", Status: "closed"}, {ID: "gt-c", Status: "closed"}}, false},
+		{"vanished", nil, false},
+	}
+	for _, tt := range tests {
+		if got := trackedIssuesUnchanged(before, tt.after); got != tt.want {
+			t.Errorf("%s: trackedIssuesUnchanged = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestUniqueIssueIDs(t *testing.T) {
+	got := uniqueIssueIDs([]string{"gt-a", "bd-b", "gt-a", " ", "bd-c", "bd-b"})
+	want := []string{"gt-a", "bd-b", "bd-c"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("uniqueIssueIDs = %v, want %v", got, want)
+	}
+}
+
+func TestFormatBdCommand(t *testing.T) {
+	got := formatBdCommand([]string{"create", "--id=hq-cv-abc", "--title=Deploy v2.0", "--description=it's\nhere"})
+	want := `bd create --id=hq-cv-abc '--title=Deploy v2.0' '--description=it'\''s` + "\n" + `here'`
+	if got != want {
+		t.Errorf("formatBdCommand = %q, want %q", got, want)
+	}
+}
+
+func TestConvoyClosedByMatches(t *testing.T) {
+	t.Setenv("GT_ROLE", "mayor")
+	reason := convoyCloseReason("All tracked issues completed", "gt cleanup")
+	if want := "All tracked issues completed [closed-by: mayor/ via gt cleanup]"; reason != want {
+		t.Fatalf("convoyCloseReason = %q, want %q", reason, want)
+	}
+
+	tests := []struct {
+		reason, filter string
+		want           bool
+	}{
+		{reason, "gt cleanup", true},
+		{reason, "GT Cleanup", true},
+		{reason, "mayor/", true},
+		{reason, "me", true},
+		{reason, "gt convoy check", false},
+		{reason, "manual", false},
+		{"Done by hand", "manual", true},
+		{"", "manual", true},
+		{"Done by hand", "me", false},
+	}
+	for _, tt := range tests {
+		if got := convoyClosedByMatches(tt.reason, tt.filter, "mayor/"); got != tt.want {
+			t.Errorf("convoyClosedByMatches(%q, %q) = %v, want %v", tt.reason, tt.filter, got, tt.want)
+		}
+	}
+}
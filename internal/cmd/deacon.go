@@ -854,17 +854,17 @@ func runDeaconHealthState(cmd *cobra.Command, args []string) error {
 		fmt.Printf("Agent: %s\n", style.Bold.Render(agentID))
 
 		if !agentState.LastPingTime.IsZero() {
-			fmt.Printf("  Last ping: %s ago\n", time.Since(agentState.LastPingTime).Round(time.Second))
+			fmt.Printf("  Last ping: %s\n", style.HumanizeTime(agentState.LastPingTime))
 		}
 		if !agentState.LastResponseTime.IsZero() {
-			fmt.Printf("  Last response: %s ago\n", time.Since(agentState.LastResponseTime).Round(time.Second))
+			fmt.Printf("  Last response: %s\n", style.HumanizeTime(agentState.LastResponseTime))
 		}
 
 		fmt.Printf("  Consecutive failures: %d\n", agentState.ConsecutiveFailures)
 		fmt.Printf("  Total force-kills: %d\n", agentState.ForceKillCount)
 
 		if !agentState.LastForceKillTime.IsZero() {
-			fmt.Printf("  Last force-kill: %s ago\n", time.Since(agentState.LastForceKillTime).Round(time.Second))
+			fmt.Printf("  Last force-kill: %s\n", style.HumanizeTime(agentState.LastForceKillTime))
 			if agentState.IsInCooldown(healthCheckCooldown) {
 				remaining := agentState.CooldownRemaining(healthCheckCooldown)
 				fmt.Printf("  Cooldown: %s remaining\n", remaining.Round(time.Second))
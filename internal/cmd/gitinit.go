@@ -63,6 +63,9 @@ const HQGitignore = `# Gas Town HQ .gitignore
 **/*.lock
 **/registry.json
 
+# Personal overrides of mayor/rigs.json (see 'gt cleanup --help')
+mayor/rigs.local.json
+
 # =============================================================================
 # Rig git worktrees (recreate with 'gt sling' or 'gt rig add')
 # =============================================================================
@@ -276,23 +276,7 @@ func formatTimeAgo(timestamp string) string {
 		return "" // Can't parse, return empty
 	}
 
-	d := time.Since(t)
-	if d < 0 {
-		return style.Dim.Render("(in the future)")
-	}
-
-	var ago string
-	if d < time.Minute {
-		ago = fmt.Sprintf("%ds ago", int(d.Seconds()))
-	} else if d < time.Hour {
-		ago = fmt.Sprintf("%dm ago", int(d.Minutes()))
-	} else if d < 24*time.Hour {
-		ago = fmt.Sprintf("%dh ago", int(d.Hours()))
-	} else {
-		ago = fmt.Sprintf("%dd ago", int(d.Hours()/24))
-	}
-
-	return style.Dim.Render("(" + ago + ")")
+	return style.Dim.Render("(" + style.HumanizeTime(t) + ")")
 }
 
 // truncateString truncates a string to maxLen, adding "..." if truncated.
@@ -104,7 +104,7 @@ func runOrphans(cmd *cobra.Command, args []string) error {
 	fmt.Printf("%s Found %d orphaned commit(s):\n\n", style.Warning.Render("⚠"), len(filtered))
 
 	for _, o := range filtered {
-		age := formatAge(o.Date)
+		age := style.HumanizeTime(o.Date)
 		fmt.Printf("  %s %s\n", style.Bold.Render(o.SHA[:8]), o.Subject)
 		fmt.Printf("    %s by %s\n\n", style.Dim.Render(age), o.Author)
 	}
@@ -226,20 +226,3 @@ func isNoiseCommit(subject string) bool {
 
 	return false
 }
-
-// formatAge returns a human-readable age string
-func formatAge(t time.Time) string {
-	d := time.Since(t)
-
-	if d < time.Hour {
-		return fmt.Sprintf("%d minutes ago", int(d.Minutes()))
-	}
-	if d < 24*time.Hour {
-		return fmt.Sprintf("%d hours ago", int(d.Hours()))
-	}
-	days := int(d.Hours() / 24)
-	if days == 1 {
-		return "1 day ago"
-	}
-	return fmt.Sprintf("%d days ago", days)
-}
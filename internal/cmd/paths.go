@@ -0,0 +1,30 @@
+package cmd
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// absolutePaths disables displayPath's shortening (--absolute-paths).
+var absolutePaths bool
+
+// displayPath renders a path for human output: relative to the town root
+// containing it (e.g. "gastown/polecats/Toast"), so deep trees stay
+// scannable. Paths outside a town, and all paths under --absolute-paths,
+// are shown in full. JSON output should keep using full paths.
+func displayPath(path string) string {
+	if absolutePaths || !filepath.IsAbs(path) {
+		return path
+	}
+	townRoot, err := workspace.Find(path)
+	if err != nil || townRoot == "" {
+		return path
+	}
+	rel, err := filepath.Rel(townRoot, path)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return path
+	}
+	return rel
+}
@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDisplayPath(t *testing.T) {
+	town := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(town, "mayor"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(town, "mayor", "town.json"), []byte(`{"type":"town"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	clone := filepath.Join(town, "gastown", "polecats", "Toast")
+	if err := os.MkdirAll(clone, 0755); err != nil {
+		t.Fatal(err)
+	}
+	outside := t.TempDir()
+
+	want := filepath.Join("gastown", "polecats", "Toast")
+	if got := displayPath(clone); got != want {
+		t.Errorf("displayPath(in town) = %q, want %q", got, want)
+	}
+	if got := displayPath(outside); got != outside {
+		t.Errorf("displayPath(outside town) = %q, want %q", got, outside)
+	}
+	if got := displayPath("relative/path"); got != "relative/path" {
+		t.Errorf("displayPath(relative) = %q, want unchanged", got)
+	}
+
+	absolutePaths = true
+	defer func() { absolutePaths = false }()
+	if got := displayPath(clone); got != clone {
+		t.Errorf("--absolute-paths: displayPath = %q, want %q", got, clone)
+	}
+}
@@ -22,10 +22,13 @@ import (
 
 // Polecat command flags
 var (
-	polecatListJSON  bool
-	polecatListAll   bool
-	polecatForce     bool
-	polecatRemoveAll bool
+	polecatListJSON   bool
+	polecatListAll    bool
+	polecatListTree   bool
+	polecatListStale  bool
+	polecatForce      bool
+	polecatRemoveAll  bool
+	polecatAddSession bool
 )
 
 var polecatCmd = &cobra.Command{
@@ -54,20 +57,39 @@ all currently active polecats with their states:
 Examples:
   gt polecat list greenplace
   gt polecat list --all
-  gt polecat list greenplace --json`,
+  gt polecat list greenplace --json
+  gt polecat list --all --tree     # Grouped by rig, with state and age
+  gt polecat list --all --stale-branches
+
+--tree groups polecats under their rigs with a state glyph, a session
+indicator (● running), and how long ago the state last changed. Done
+polecats - the ones 'gt cleanup' would reap - are highlighted.
+
+--stale-branches lists each rig's polecat branches instead: whether each is
+merged into the rig's default branch, and which polecat uses it and in
+what state. A merged branch whose polecat isn't done yet is flagged as a
+reconcile candidate - the work landed but the polecat never finished. A
+merged branch no polecat uses is a gc candidate for 'gt cleanup --gc'.
+Nothing is changed.`,
 	RunE: runPolecatList,
 }
 
 var polecatAddCmd = &cobra.Command{
-	Use:   "add <rig> <name>",
-	Short: "Add a new polecat to a rig",
+	Use:     "add <rig> <name>",
+	Aliases: []string{"new"},
+	Short:   "Add a new polecat to a rig",
 	Long: `Add a new polecat to a rig.
 
-Creates a polecat directory, clones the rig repo, creates a work branch,
-and initializes state.
+Creates a polecat directory, a git worktree on a fresh work branch, and
+opens the polecat's agent bead. Use --session to also start its tmux session.
 
-Example:
-  gt polecat add greenplace Toast`,
+Names must not contain path separators, whitespace, or characters git
+rejects in branch names, and cannot be reserved rig agent names
+(witness, refinery, crew-*).
+
+Examples:
+  gt polecat add greenplace Toast
+  gt polecat new greenplace Toast --session`,
 	Args: cobra.ExactArgs(2),
 	RunE: runPolecatAdd,
 }
@@ -153,6 +175,9 @@ This command removes orphaned branches:
   - Branches for polecats that no longer exist
   - Old timestamped branches (keeps only the current one per polecat)
 
+Only branches already merged into the rig's default branch are deleted.
+Orphaned branches with unmerged work are kept and reported.
+
 Examples:
   gt polecat gc greenplace
   gt polecat gc greenplace --dry-run`,
@@ -240,12 +265,18 @@ var polecatStaleCmd = &cobra.Command{
 	Long: `Detect stale polecats in a rig that are candidates for cleanup.
 
 A polecat is considered stale if:
+  - It is not done
   - No active tmux session
   - Way behind main (>threshold commits) OR no agent bead
   - Has no uncommitted work that could be lost
 
 The default threshold is 20 commits behind main.
 
+Staleness is about abandonment: a polecat that stopped without finishing.
+Done polecats finished their work and are never reported as stale - they
+are reaped by 'gt cleanup', which removes them regardless of session or
+worktree state.
+
 Use --cleanup to automatically nuke stale polecats that are safe to remove.
 Use --dry-run with --cleanup to see what would be cleaned.
 
@@ -263,6 +294,8 @@ func init() {
 	// List flags
 	polecatListCmd.Flags().BoolVar(&polecatListJSON, "json", false, "Output as JSON")
 	polecatListCmd.Flags().BoolVar(&polecatListAll, "all", false, "List polecats in all rigs")
+	polecatListCmd.Flags().BoolVar(&polecatListTree, "tree", false, "Group polecats under their rigs with state glyphs and age")
+	polecatListCmd.Flags().BoolVar(&polecatListStale, "stale-branches", false, "List polecat branches with merge status and polecat state")
 
 	// Remove flags
 	polecatRemoveCmd.Flags().BoolVarP(&polecatForce, "force", "f", false, "Force removal, bypassing checks")
@@ -286,6 +319,9 @@ func init() {
 	polecatNukeCmd.Flags().BoolVar(&polecatNukeDryRun, "dry-run", false, "Show what would be nuked without doing it")
 	polecatNukeCmd.Flags().BoolVarP(&polecatNukeForce, "force", "f", false, "Force nuke, bypassing all safety checks (LOSES WORK)")
 
+	// Add flags
+	polecatAddCmd.Flags().BoolVar(&polecatAddSession, "session", false, "Start the polecat's tmux session after creating it")
+
 	// Check-recovery flags
 	polecatCheckRecoveryCmd.Flags().BoolVar(&polecatCheckRecoveryJSON, "json", false, "Output as JSON")
 
@@ -316,6 +352,8 @@ type PolecatListItem struct {
 	State          polecat.State `json:"state"`
 	Issue          string        `json:"issue,omitempty"`
 	SessionRunning bool          `json:"session_running"`
+
+	changedAt time.Time // When the state last changed; only filled for --tree
 }
 
 // getPolecatManager creates a polecat manager for the given rig.
@@ -332,6 +370,12 @@ func getPolecatManager(rigName string) (*polecat.Manager, *rig.Rig, error) {
 }
 
 func runPolecatList(cmd *cobra.Command, args []string) error {
+	if polecatListTree && polecatListJSON {
+		return fmt.Errorf("--tree and --json cannot be combined")
+	}
+	if polecatListTree && polecatListStale {
+		return fmt.Errorf("--tree and --stale-branches cannot be combined")
+	}
 	var rigs []*rig.Rig
 
 	if polecatListAll {
@@ -353,9 +397,20 @@ func runPolecatList(cmd *cobra.Command, args []string) error {
 		rigs = []*rig.Rig{r}
 	}
 
-	// Collect polecats from all rigs
+	if polecatListStale {
+		return runPolecatListBranches(rigs)
+	}
+
+	// Collect polecats from all rigs. One snapshot of tmux sessions serves
+	// every polecat instead of a has-session call each.
 	t := tmux.NewTmux()
 	var allPolecats []PolecatListItem
+	var listed []*rig.Rig
+	sessions, snapErr := t.ListSessions()
+	running := make(map[string]bool, len(sessions))
+	for _, s := range sessions {
+		running[s] = true
+	}
 
 	for _, r := range rigs {
 		polecatGit := git.NewGit(r.Path)
@@ -367,16 +422,24 @@ func runPolecatList(cmd *cobra.Command, args []string) error {
 			fmt.Fprintf(os.Stderr, "warning: failed to list polecats in %s: %v\n", r.Name, err)
 			continue
 		}
+		listed = append(listed, r)
 
 		for _, p := range polecats {
-			running, _ := polecatMgr.IsRunning(p.Name)
-			allPolecats = append(allPolecats, PolecatListItem{
+			isRunning := running[polecatMgr.SessionName(p.Name)]
+			if snapErr != nil {
+				isRunning, _ = polecatMgr.IsRunning(p.Name)
+			}
+			item := PolecatListItem{
 				Rig:            r.Name,
 				Name:           p.Name,
 				State:          p.State,
 				Issue:          p.Issue,
-				SessionRunning: running,
-			})
+				SessionRunning: isRunning,
+			}
+			if polecatListTree {
+				item.changedAt = mgr.StateChangedAt(p.Name)
+			}
+			allPolecats = append(allPolecats, item)
 		}
 	}
 
@@ -387,6 +450,11 @@ func runPolecatList(cmd *cobra.Command, args []string) error {
 		return enc.Encode(allPolecats)
 	}
 
+	if polecatListTree {
+		printPolecatTree(os.Stdout, listed, allPolecats, time.Now())
+		return nil
+	}
+
 	if len(allPolecats) == 0 {
 		fmt.Println("No active polecats found.")
 		return nil
@@ -429,7 +497,11 @@ func runPolecatAdd(cmd *cobra.Command, args []string) error {
 	rigName := args[0]
 	polecatName := args[1]
 
-	mgr, _, err := getPolecatManager(rigName)
+	if err := polecat.ValidateName(polecatName); err != nil {
+		return err
+	}
+
+	mgr, r, err := getPolecatManager(rigName)
 	if err != nil {
 		return err
 	}
@@ -442,8 +514,18 @@ func runPolecatAdd(cmd *cobra.Command, args []string) error {
 	}
 
 	fmt.Printf("%s Polecat %s added.\n", style.SuccessPrefix, p.Name)
-	fmt.Printf("  %s\n", style.Dim.Render(p.ClonePath))
+	fmt.Printf("  %s\n", style.Dim.Render(displayPath(p.ClonePath)))
 	fmt.Printf("  Branch: %s\n", style.Dim.Render(p.Branch))
+	fmt.Printf("  Agent bead: %s\n", style.Dim.Render(polecat.AgentBeadID(r, p.Name)))
+
+	if polecatAddSession {
+		sessMgr := polecat.NewSessionManager(tmux.NewTmux(), r)
+		if err := sessMgr.Start(p.Name, polecat.SessionStartOptions{}); err != nil {
+			return fmt.Errorf("starting session: %w", err)
+		}
+		fmt.Printf("%s Session started. Attach with: %s\n", style.SuccessPrefix,
+			style.Dim.Render(fmt.Sprintf("gt session at %s/%s", rigName, p.Name)))
+	}
 
 	return nil
 }
@@ -499,11 +581,11 @@ func runPolecatRemove(cmd *cobra.Command, args []string) error {
 	}
 
 	if removed > 0 {
-		fmt.Printf("\n%s Removed %d polecat(s).\n", style.SuccessPrefix, removed)
+		fmt.Printf("\n%s Removed %s.\n", style.SuccessPrefix, style.Count(removed, "polecat", "polecats"))
 	}
 
 	if len(removeErrors) > 0 {
-		return fmt.Errorf("%d removal(s) failed", len(removeErrors))
+		return fmt.Errorf("%s failed", style.Count(len(removeErrors), "removal", "removals"))
 	}
 
 	return nil
@@ -588,7 +670,7 @@ func runPolecatSync(cmd *cobra.Command, args []string) error {
 		for _, e := range syncErrors {
 			fmt.Printf("  - %s\n", e)
 		}
-		return fmt.Errorf("%d sync(s) failed", len(syncErrors))
+		return fmt.Errorf("%s failed", style.Count(len(syncErrors), "sync", "syncs"))
 	}
 
 	return nil
@@ -689,7 +771,7 @@ func runPolecatStatus(cmd *cobra.Command, args []string) error {
 	}
 
 	// Clone path and branch
-	fmt.Printf("  Clone:         %s\n", style.Dim.Render(p.ClonePath))
+	fmt.Printf("  Clone:         %s\n", style.Dim.Render(displayPath(p.ClonePath)))
 	fmt.Printf("  Branch:        %s\n", style.Dim.Render(p.Branch))
 
 	// Session info
@@ -716,7 +798,7 @@ func runPolecatStatus(cmd *cobra.Command, args []string) error {
 
 		if !sessInfo.LastActivity.IsZero() {
 			// Show relative time for activity
-			ago := formatActivityTime(sessInfo.LastActivity)
+			ago := style.HumanizeTime(sessInfo.LastActivity)
 			fmt.Printf("  Last Activity: %s (%s)\n",
 				sessInfo.LastActivity.Format("15:04:05"),
 				style.Dim.Render(ago))
@@ -728,21 +810,6 @@ func runPolecatStatus(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-// formatActivityTime returns a human-readable relative time string.
-func formatActivityTime(t time.Time) string {
-	d := time.Since(t)
-	switch {
-	case d < time.Minute:
-		return fmt.Sprintf("%d seconds ago", int(d.Seconds()))
-	case d < time.Hour:
-		return fmt.Sprintf("%d minutes ago", int(d.Minutes()))
-	case d < 24*time.Hour:
-		return fmt.Sprintf("%d hours ago", int(d.Hours()))
-	default:
-		return fmt.Sprintf("%d days ago", int(d.Hours()/24))
-	}
-}
-
 // GitState represents the git state of a polecat's worktree.
 type GitState struct {
 	Clean            bool     `json:"clean"`
@@ -947,7 +1014,7 @@ func runPolecatCheckRecovery(cmd *cobra.Command, args []string) error {
 	// We need to read it directly from beads since manager doesn't expose it
 	rigPath := r.Path
 	bd := beads.New(rigPath)
-	agentBeadID := beads.PolecatBeadID(rigName, polecatName)
+	agentBeadID := polecat.AgentBeadID(r, polecatName)
 	_, fields, err := bd.GetAgentBead(agentBeadID)
 
 	status := RecoveryStatus{
@@ -1040,47 +1107,36 @@ func runPolecatGC(cmd *cobra.Command, args []string) error {
 
 	if polecatGCDryRun {
 		// Dry run - list branches that would be deleted
-		repoGit := git.NewGit(r.Path)
-
-		// List all polecat branches
-		branches, err := repoGit.ListBranches("polecat/*")
+		stale, baseRef, err := mgr.StaleBranches()
 		if err != nil {
-			return fmt.Errorf("listing branches: %w", err)
+			return fmt.Errorf("finding stale branches: %w", err)
 		}
 
-		if len(branches) == 0 {
-			fmt.Println("No polecat branches found.")
+		if len(stale) == 0 {
+			fmt.Println("No stale polecat branches found.")
 			return nil
 		}
 
-		// Get current branches
-		polecats, err := mgr.List()
-		if err != nil {
-			return fmt.Errorf("listing polecats: %w", err)
-		}
-
-		currentBranches := make(map[string]bool)
-		for _, p := range polecats {
-			currentBranches[p.Branch] = true
-		}
-
 		// Show what would be deleted
 		toDelete := 0
-		for _, branch := range branches {
-			if !currentBranches[branch] {
-				fmt.Printf("  Would delete: %s\n", style.Dim.Render(branch))
+		for _, branch := range stale {
+			if branch.Merged {
+				fmt.Printf("  Would delete: %s\n", style.Dim.Render(branch.Name))
 				toDelete++
 			} else {
-				fmt.Printf("  Keep (in use): %s\n", style.Success.Render(branch))
+				fmt.Printf("  Keep (not merged into %s): %s\n", baseRef, style.Warning.Render(branch.Name))
 			}
 		}
 
-		fmt.Printf("\nWould delete %d branch(es), keep %d\n", toDelete, len(branches)-toDelete)
+		fmt.Printf("\nWould delete %s, keep %d\n", style.Count(toDelete, "branch", "branches"), len(stale)-toDelete)
 		return nil
 	}
 
 	// Actually clean up
-	deleted, err := mgr.CleanupStaleBranches()
+	deleted, failed, err := mgr.CleanupStaleBranches()
+	for _, f := range failed {
+		style.PrintWarning("%v", f)
+	}
 	if err != nil {
 		return fmt.Errorf("cleanup failed: %w", err)
 	}
@@ -1088,7 +1144,7 @@ func runPolecatGC(cmd *cobra.Command, args []string) error {
 	if deleted == 0 {
 		fmt.Println("No stale branches to clean up.")
 	} else {
-		fmt.Printf("%s Deleted %d stale branch(es).\n", style.SuccessPrefix, deleted)
+		fmt.Printf("%s Deleted %s.\n", style.SuccessPrefix, style.Count(deleted, "stale branch", "stale branches"))
 	}
 
 	return nil
@@ -1133,7 +1189,7 @@ func runPolecatNuke(cmd *cobra.Command, args []string) error {
 
 		if len(blocked) > 0 {
 			displaySafetyCheckBlocked(blocked)
-			return fmt.Errorf("blocked: %d polecat(s) have active work", len(blocked))
+			return fmt.Errorf("blocked: %s active work", style.Count(len(blocked), "polecat has", "polecats have"))
 		}
 	}
 
@@ -1145,10 +1201,10 @@ func runPolecatNuke(cmd *cobra.Command, args []string) error {
 	for _, p := range targets {
 		if polecatNukeDryRun {
 			fmt.Printf("Would nuke %s/%s:\n", p.rigName, p.polecatName)
-			fmt.Printf("  - Kill session: gt-%s-%s\n", p.rigName, p.polecatName)
-			fmt.Printf("  - Delete worktree: %s/polecats/%s\n", p.r.Path, p.polecatName)
+			fmt.Printf("  - Kill session: %s\n", polecat.SessionName(p.rigName, p.polecatName))
+			fmt.Printf("  - Delete worktree: %s\n", displayPath(filepath.Join(p.r.Path, "polecats", p.polecatName)))
 			fmt.Printf("  - Delete branch (if exists)\n")
-			fmt.Printf("  - Close agent bead: %s\n", beads.PolecatBeadID(p.rigName, p.polecatName))
+			fmt.Printf("  - Close agent bead: %s\n", polecat.AgentBeadID(p.r, p.polecatName))
 
 			displayDryRunSafetyCheck(p)
 			fmt.Println()
@@ -1204,7 +1260,7 @@ func runPolecatNuke(cmd *cobra.Command, args []string) error {
 		}
 
 		// Step 5: Close agent bead (if exists)
-		agentBeadID := beads.PolecatBeadID(p.rigName, p.polecatName)
+		agentBeadID := polecat.AgentBeadID(p.r, p.polecatName)
 		closeArgs := []string{"close", agentBeadID, "--reason=nuked"}
 		if sessionID := runtime.SessionIDFromEnv(); sessionID != "" {
 			closeArgs = append(closeArgs, "--session="+sessionID)
@@ -1223,7 +1279,7 @@ func runPolecatNuke(cmd *cobra.Command, args []string) error {
 
 	// Report results
 	if polecatNukeDryRun {
-		fmt.Printf("\n%s Would nuke %d polecat(s).\n", style.Info.Render("ℹ"), len(targets))
+		fmt.Printf("\n%s Would nuke %s.\n", style.Info.Render("ℹ"), style.Count(len(targets), "polecat", "polecats"))
 		return nil
 	}
 
@@ -1235,50 +1291,56 @@ func runPolecatNuke(cmd *cobra.Command, args []string) error {
 	}
 
 	if nuked > 0 {
-		fmt.Printf("\n%s Nuked %d polecat(s).\n", style.SuccessPrefix, nuked)
+		fmt.Printf("\n%s Nuked %s.\n", style.SuccessPrefix, style.Count(nuked, "polecat", "polecats"))
 	}
 
 	if len(nukeErrors) > 0 {
-		return fmt.Errorf("%d nuke(s) failed", len(nukeErrors))
+		return fmt.Errorf("%s failed", style.Count(len(nukeErrors), "nuke", "nukes"))
 	}
 
 	return nil
 }
 
 func runPolecatStale(cmd *cobra.Command, args []string) error {
+	if polecatStaleJSON && polecatStaleCleanup {
+		return fmt.Errorf("--json cannot be combined with --cleanup")
+	}
+
 	rigName := args[0]
 	mgr, r, err := getPolecatManager(rigName)
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("Detecting stale polecats in %s (threshold: %d commits behind main)...\n\n", r.Name, polecatStaleThreshold)
-
-	staleInfos, err := mgr.DetectStalePolecats(polecatStaleThreshold)
-	if err != nil {
-		return fmt.Errorf("detecting stale polecats: %w", err)
+	if !polecatStaleJSON {
+		fmt.Printf("Detecting stale polecats in %s (threshold: %d commits behind main)...\n\n", r.Name, polecatStaleThreshold)
 	}
 
-	if len(staleInfos) == 0 {
-		fmt.Println("No polecats found.")
-		return nil
+	polecats, err := mgr.List()
+	if err != nil {
+		return fmt.Errorf("detecting stale polecats: listing polecats: %w", err)
 	}
+	staleInfos := mgr.AssessStaleness(polecats, polecatStaleThreshold)
+	staleNames := polecat.SelectNames(polecats, polecat.Not(polecat.IsDone), polecat.IsStaleIn(staleInfos))
 
 	// JSON output
 	if polecatStaleJSON {
-		return json.NewEncoder(os.Stdout).Encode(staleInfos)
+		if staleInfos == nil {
+			staleInfos = []*polecat.StalenessInfo{}
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(staleInfos)
 	}
 
-	// Summary counts
-	var staleCount, safeCount int
-	for _, info := range staleInfos {
-		if info.IsStale {
-			staleCount++
-		} else {
-			safeCount++
-		}
+	if len(staleInfos) == 0 {
+		fmt.Println("No polecats found.")
+		return nil
 	}
 
+	staleCount := len(staleNames)
+	safeCount := len(staleInfos) - staleCount
+
 	// Display results
 	for _, info := range staleInfos {
 		statusIcon := style.Success.Render("●")
@@ -1330,28 +1392,25 @@ func runPolecatStale(cmd *cobra.Command, args []string) error {
 	if polecatStaleCleanup && staleCount > 0 {
 		fmt.Println()
 		if polecatNukeDryRun {
-			fmt.Printf("Would clean up %d stale polecat(s):\n", staleCount)
+			fmt.Printf("Would clean up %s:\n", style.Count(staleCount, "stale polecat", "stale polecats"))
 			for _, info := range staleInfos {
 				if info.IsStale {
 					fmt.Printf("  - %s: %s\n", info.Name, info.Reason)
 				}
 			}
 		} else {
-			fmt.Printf("Cleaning up %d stale polecat(s)...\n", staleCount)
+			fmt.Printf("Cleaning up %s...\n", style.Count(staleCount, "stale polecat", "stale polecats"))
 			nuked := 0
-			for _, info := range staleInfos {
-				if !info.IsStale {
-					continue
-				}
-				fmt.Printf("  Nuking %s...", info.Name)
-				if err := mgr.RemoveWithOptions(info.Name, true, false); err != nil {
+			for _, name := range staleNames {
+				fmt.Printf("  Nuking %s...", name)
+				if err := mgr.RemoveWithOptions(name, true, false); err != nil {
 					fmt.Printf(" %s (%v)\n", style.Error.Render("failed"), err)
 				} else {
 					fmt.Printf(" %s\n", style.Success.Render("done"))
 					nuked++
 				}
 			}
-			fmt.Printf("\n%s Nuked %d stale polecat(s).\n", style.SuccessPrefix, nuked)
+			fmt.Printf("\n%s Nuked %s.\n", style.SuccessPrefix, style.Count(nuked, "stale polecat", "stale polecats"))
 		}
 	}
 
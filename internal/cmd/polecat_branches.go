@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// PolecatBranchItem is a polecat branch in 'gt polecat list --stale-branches
+// --json' output.
+type PolecatBranchItem struct {
+	Rig string `json:"rig"`
+	polecat.PolecatBranch
+	BaseRef string `json:"base_ref"` // The default branch merge status was checked against
+}
+
+// polecatBranchReport is one rig's section of --stale-branches.
+type polecatBranchReport struct {
+	Rig      string
+	BaseRef  string
+	Branches []polecat.PolecatBranch
+}
+
+// runPolecatListBranches is 'gt polecat list --stale-branches': each rig's
+// polecat branches with their merge status and the state of the polecat
+// using them, if any.
+func runPolecatListBranches(rigs []*rig.Rig) error {
+	var reports []polecatBranchReport
+	for _, r := range rigs {
+		mgr := polecat.NewManager(r, git.NewGit(r.Path))
+		branches, baseRef, err := mgr.PolecatBranches()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "warning: failed to check branches in %s: %v\n", r.Name, err)
+			continue
+		}
+		reports = append(reports, polecatBranchReport{Rig: r.Name, BaseRef: baseRef, Branches: branches})
+	}
+
+	if polecatListJSON {
+		items := []PolecatBranchItem{}
+		for _, rep := range reports {
+			for _, b := range rep.Branches {
+				items = append(items, PolecatBranchItem{Rig: rep.Rig, PolecatBranch: b, BaseRef: rep.BaseRef})
+			}
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(items)
+	}
+
+	printPolecatBranches(os.Stdout, reports)
+	return nil
+}
+
+// printPolecatBranches renders --stale-branches. Merged branches of polecats
+// that aren't done yet are flagged as reconcile candidates; merged branches
+// no polecat uses are what 'gt cleanup --gc' deletes.
+func printPolecatBranches(w io.Writer, reports []polecatBranchReport) {
+	var reconcile, gc int
+	for i, rep := range reports {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "%s %s\n", style.Bold.Render(rep.Rig), style.Dim.Render("(against "+rep.BaseRef+")"))
+		if len(rep.Branches) == 0 {
+			fmt.Fprintf(w, "  %s\n", style.Dim.Render("(no polecat branches)"))
+			continue
+		}
+
+		branchWidth, ownerWidth := 0, 0
+		owners := make([]string, len(rep.Branches))
+		for j, b := range rep.Branches {
+			owners[j] = "(no polecat)"
+			if b.Polecat != "" {
+				owners[j] = fmt.Sprintf("%s (%s)", b.Polecat, b.State)
+			}
+			branchWidth = max(branchWidth, len(b.Branch))
+			ownerWidth = max(ownerWidth, len(owners[j]))
+		}
+		for j, b := range rep.Branches {
+			status := style.Dim.Render("○ unmerged")
+			if b.Merged {
+				status = style.Success.Render("✓ merged  ")
+			}
+			line := fmt.Sprintf("  %s  %-*s  %-*s", status, branchWidth, b.Branch, ownerWidth, owners[j])
+
+			switch {
+			case b.Merged && b.Polecat != "" && b.State != polecat.StateDone:
+				line += "  " + style.Warning.Render("⚠ merged but polecat still "+string(b.State)+" - reconcile candidate")
+				reconcile++
+			case b.Merged && b.Polecat == "":
+				line += "  " + style.Dim.Render("gc candidate")
+				gc++
+			}
+			fmt.Fprintln(w, line)
+		}
+	}
+
+	if reconcile > 0 || gc > 0 {
+		fmt.Fprintln(w)
+	}
+	if reconcile > 0 {
+		fmt.Fprintf(w, "%s\n", style.Warning.Render(fmt.Sprintf("%s already merged (reconcile candidates)",
+			style.Count(reconcile, "active polecat's branch", "active polecats' branches"))))
+	}
+	if gc > 0 {
+		fmt.Fprintf(w, "%s\n", style.Dim.Render(fmt.Sprintf("%s would be deleted by 'gt cleanup --gc'",
+			style.Count(gc, "merged orphan branch", "merged orphan branches"))))
+	}
+}
@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/polecat"
+)
+
+func TestPrintPolecatBranches(t *testing.T) {
+	reports := []polecatBranchReport{
+		{Rig: "gastown", BaseRef: "origin/main", Branches: []polecat.PolecatBranch{
+			{Branch: "polecat/Toast-2", Merged: true, Polecat: "Toast", State: polecat.StateWorking},
+			{Branch: "polecat/Nux-1", Merged: true, Polecat: "Nux", State: polecat.StateDone},
+			{Branch: "polecat/Old-3", Merged: true},
+			{Branch: "polecat/Wip-4"},
+		}},
+		{Rig: "beads", BaseRef: "main"},
+	}
+
+	var buf bytes.Buffer
+	printPolecatBranches(&buf, reports)
+	out := buf.String()
+
+	for _, want := range []string{
+		"gastown (against origin/main)",
+		"✓ merged    polecat/Toast-2  Toast (working)  ⚠ merged but polecat still working - reconcile candidate",
+		"✓ merged    polecat/Old-3    (no polecat)     gc candidate",
+		"○ unmerged  polecat/Wip-4    (no polecat)",
+		"beads (against main)",
+		"(no polecat branches)",
+		"1 active polecat's branch already merged (reconcile candidates)",
+		"1 merged orphan branch would be deleted by 'gt cleanup --gc'",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+	for _, line := range strings.Split(out, "\n") {
+		if strings.Contains(line, "Nux (done)") && strings.Contains(line, "reconcile") {
+			t.Errorf("done polecat flagged as reconcile candidate: %q", line)
+		}
+	}
+}
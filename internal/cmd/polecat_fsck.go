@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+var (
+	polecatFsckJSON bool
+	polecatFsckAll  bool
+)
+
+var polecatFsckCmd = &cobra.Command{
+	Use:   "fsck [rig]",
+	Short: "Check polecat worktree/branch/session/bead consistency",
+	Long: `Check that every polecat's pieces agree with each other.
+
+For each polecat, verifies:
+  - The worktree exists (a directory without one is half-removed)
+  - The worktree's branch exists in the repo
+  - Session state matches polecat state (done + running session = zombie,
+    working + no session = stalled)
+  - The agent bead exists and isn't closed while the polecat is still present
+
+Also reports running polecat sessions and open polecat agent beads whose
+polecat directory no longer exists.
+
+Exits non-zero if any inconsistency is found.
+
+Examples:
+  gt polecat fsck greenplace
+  gt polecat fsck --all
+  gt polecat fsck --all --json`,
+	RunE: runPolecatFsck,
+}
+
+func init() {
+	polecatFsckCmd.Flags().BoolVar(&polecatFsckJSON, "json", false, "Output as JSON")
+	polecatFsckCmd.Flags().BoolVar(&polecatFsckAll, "all", false, "Check polecats in all rigs")
+
+	polecatCmd.AddCommand(polecatFsckCmd)
+}
+
+func runPolecatFsck(cmd *cobra.Command, args []string) error {
+	var rigs []*rig.Rig
+
+	if polecatFsckAll {
+		allRigs, _, err := getAllRigs()
+		if err != nil {
+			return err
+		}
+		rigs = allRigs
+	} else {
+		if len(args) < 1 {
+			return fmt.Errorf("rig name required (or use --all)")
+		}
+		_, r, err := getPolecatManager(args[0])
+		if err != nil {
+			return err
+		}
+		rigs = []*rig.Rig{r}
+	}
+
+	t := tmux.NewTmux()
+	reports := make([]*polecat.FsckReport, 0, len(rigs))
+	problems := 0
+
+	for _, r := range rigs {
+		mgr := polecat.NewManager(r, git.NewGit(r.Path))
+		report, err := mgr.Fsck(polecat.NewSessionManager(t, r))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "warning: fsck failed in %s: %v\n", r.Name, err)
+			continue
+		}
+		reports = append(reports, report)
+		problems += report.ProblemCount()
+	}
+
+	if polecatFsckJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(reports); err != nil {
+			return err
+		}
+	} else {
+		for _, report := range reports {
+			printFsckReport(report)
+		}
+		if problems == 0 {
+			fmt.Printf("%s No inconsistencies found.\n", style.SuccessPrefix)
+		} else {
+			fmt.Printf("%s %s found.\n", style.WarningPrefix, style.Count(problems, "inconsistency", "inconsistencies"))
+		}
+	}
+
+	if problems > 0 {
+		return NewSilentExit(1)
+	}
+	return nil
+}
+
+// printFsckReport renders one rig's consistency matrix.
+func printFsckReport(report *polecat.FsckReport) {
+	fmt.Printf("%s %s\n\n", style.Bold.Render("🔍"), style.Bold.Render(report.Rig))
+
+	if len(report.Polecats) == 0 {
+		fmt.Printf("  %s\n", style.Dim.Render("(no polecats)"))
+	} else {
+		table := style.NewTable(
+			style.Column{Name: "POLECAT", Width: 16},
+			style.Column{Name: "STATE", Width: 8},
+			style.Column{Name: "WORKTREE", Width: 8},
+			style.Column{Name: "BRANCH", Width: 6},
+			style.Column{Name: "SESSION", Width: 7},
+			style.Column{Name: "BEAD", Width: 11},
+			style.Column{Name: "OK", Width: 4},
+		)
+		for _, e := range report.Polecats {
+			bead := e.BeadStatus
+			if bead == "" {
+				bead = "missing"
+			}
+			ok := style.Success.Render("✓")
+			if !e.OK() {
+				ok = style.Error.Render("✗")
+			}
+			table.AddRow(e.Name, string(e.State), fsckMark(e.Worktree), fsckMark(e.BranchExists),
+				fsckMark(e.SessionRunning), bead, ok)
+		}
+		fmt.Print(table.Render())
+
+		for _, e := range report.Polecats {
+			if e.OK() {
+				continue
+			}
+			fmt.Printf("  %s %s: %s\n", style.WarningPrefix, e.Name, strings.Join(e.Problems, "; "))
+		}
+	}
+
+	for _, s := range report.OrphanSessions {
+		fmt.Printf("  %s orphan session: %s (no polecat directory)\n", style.WarningPrefix, s)
+	}
+	for _, b := range report.OrphanBeads {
+		fmt.Printf("  %s orphan agent bead: %s (no polecat directory)\n", style.WarningPrefix, b)
+	}
+	fmt.Println()
+}
+
+// fsckMark renders a boolean cell in the fsck matrix.
+func fsckMark(ok bool) string {
+	if ok {
+		return "yes"
+	}
+	return "no"
+}
@@ -20,7 +20,8 @@ type polecatTarget struct {
 
 // resolvePolecatTargets builds a list of polecats from command args.
 // If useAll is true, the first arg is treated as a rig name and all polecats in it are returned.
-// Otherwise, args are parsed as rig/polecat addresses.
+// Otherwise, args are rig/polecat addresses or bare polecat names, resolved
+// with polecat.Resolve (a bare name must exist in exactly one rig).
 func resolvePolecatTargets(args []string, useAll bool) ([]polecatTarget, error) {
 	var targets []polecatTarget
 
@@ -28,7 +29,7 @@ func resolvePolecatTargets(args []string, useAll bool) ([]polecatTarget, error)
 		// --all flag: first arg is just the rig name
 		rigName := args[0]
 		// Check if it looks like rig/polecat format
-		if _, _, err := parseAddress(rigName); err == nil {
+		if strings.Contains(rigName, "/") {
 			return nil, fmt.Errorf("with --all, provide just the rig name (e.g., 'gt polecat <cmd> %s --all')", strings.Split(rigName, "/")[0])
 		}
 
@@ -51,17 +52,18 @@ func resolvePolecatTargets(args []string, useAll bool) ([]polecatTarget, error)
 			})
 		}
 	} else {
-		// Multiple rig/polecat arguments - require explicit rig/polecat format
+		// Bare names are only accepted when they name an existing polecat
+		// in exactly one rig, so a rig name can't be mistaken for a polecat.
+		rigs, _, err := getAllRigs()
+		if err != nil {
+			return nil, err
+		}
 		for _, arg := range args {
-			// Validate format: must contain "/" to avoid misinterpreting rig names as polecat names
-			if !strings.Contains(arg, "/") {
-				return nil, fmt.Errorf("invalid address '%s': must be in 'rig/polecat' format (e.g., 'gastown/Toast')", arg)
-			}
-
-			rigName, polecatName, err := parseAddress(arg)
+			resolved, polecatName, err := polecat.Resolve(rigs, arg)
 			if err != nil {
 				return nil, fmt.Errorf("invalid address '%s': %w", arg, err)
 			}
+			rigName := resolved.Name
 
 			mgr, r, err := getPolecatManager(rigName)
 			if err != nil {
@@ -104,7 +106,7 @@ func checkPolecatSafety(target polecatTarget) *SafetyCheckResult {
 
 	// Check 1: Unpushed commits via cleanup_status or git state
 	bd := beads.New(target.r.Path)
-	agentBeadID := beads.PolecatBeadID(target.rigName, target.polecatName)
+	agentBeadID := polecat.AgentBeadID(target.r, target.polecatName)
 	agentIssue, fields, err := bd.GetAgentBead(agentBeadID)
 
 	if err != nil || fields == nil {
@@ -202,7 +204,7 @@ func displayDryRunSafetyCheck(target polecatTarget) {
 	fmt.Printf("\n  Safety checks:\n")
 	polecatInfo, infoErr := target.mgr.Get(target.polecatName)
 	bd := beads.New(target.r.Path)
-	agentBeadID := beads.PolecatBeadID(target.rigName, target.polecatName)
+	agentBeadID := polecat.AgentBeadID(target.r, target.polecatName)
 	agentIssue, fields, err := bd.GetAgentBead(agentBeadID)
 
 	// Check 1: Git state
@@ -0,0 +1,198 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"golang.org/x/term"
+)
+
+var (
+	polecatReapAll    bool
+	polecatReapDryRun bool
+	polecatReapYes    bool
+	polecatReapForce  bool
+	polecatReapJSON   bool
+)
+
+var polecatReapClosedBeadsCmd = &cobra.Command{
+	Use:   "reap-closed-beads [rig]",
+	Short: "Reap polecats whose agent bead is already closed",
+	Long: `Find and reap polecats whose agent bead was closed while they were
+still working.
+
+'gt cleanup' reaps done polecats and closes their agent beads. The inverse
+happens too: someone closes a polecat's agent bead by hand with bd, but the
+polecat is still present and not done. Nothing will finish it, so it is
+effectively abandoned - and since it isn't done, cleanup never reaps it.
+
+Lists those polecats and offers to reap them: their session is stopped and
+their worktree and branch removed. The agent bead is already closed.
+
+Polecats with uncommitted work or a running session are listed but skipped
+unless --force is given.
+
+Examples:
+  gt polecat reap-closed-beads greenplace
+  gt polecat reap-closed-beads --all --dry-run
+  gt polecat reap-closed-beads --all --yes
+  gt polecat reap-closed-beads greenplace --json`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runPolecatReapClosedBeads,
+}
+
+func init() {
+	polecatReapClosedBeadsCmd.Flags().BoolVar(&polecatReapAll, "all", false, "Check polecats in all rigs")
+	polecatReapClosedBeadsCmd.Flags().BoolVar(&polecatReapDryRun, "dry-run", false, "List the polecats without reaping them")
+	polecatReapClosedBeadsCmd.Flags().BoolVarP(&polecatReapYes, "yes", "y", false, "Reap without asking for confirmation")
+	polecatReapClosedBeadsCmd.Flags().BoolVarP(&polecatReapForce, "force", "f", false, "Also reap polecats with uncommitted work or a running session")
+	polecatReapClosedBeadsCmd.Flags().BoolVar(&polecatReapJSON, "json", false, "Output the polecats as JSON (implies --dry-run)")
+
+	polecatCmd.AddCommand(polecatReapClosedBeadsCmd)
+}
+
+// closedBeadPolecat is a reap candidate found by 'gt polecat reap-closed-beads'.
+type closedBeadPolecat struct {
+	Rig string `json:"rig"`
+	polecat.ClosedBeadPolecat
+	SessionRunning bool `json:"session_running"`
+
+	r   *rig.Rig
+	mgr *polecat.Manager
+}
+
+// blocker returns why the candidate is skipped without --force, or "".
+func (c closedBeadPolecat) blocker() string {
+	switch {
+	case c.HasUncommittedWork:
+		return "has uncommitted work"
+	case c.SessionRunning:
+		return "session still running"
+	}
+	return ""
+}
+
+func runPolecatReapClosedBeads(cmd *cobra.Command, args []string) error {
+	if polecatReapJSON && polecatReapYes {
+		return fmt.Errorf("--json cannot be combined with --yes")
+	}
+
+	var rigs []*rig.Rig
+	if polecatReapAll {
+		allRigs, _, err := getAllRigs()
+		if err != nil {
+			return err
+		}
+		rigs = allRigs
+	} else {
+		if len(args) < 1 {
+			return fmt.Errorf("rig name required (or use --all)")
+		}
+		_, r, err := getPolecatManager(args[0])
+		if err != nil {
+			return err
+		}
+		rigs = []*rig.Rig{r}
+	}
+
+	t := tmux.NewTmux()
+	var candidates []closedBeadPolecat
+	for _, r := range rigs {
+		mgr := polecat.NewManager(r, git.NewGit(r.Path))
+		found, err := mgr.FindClosedBeadPolecats()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "warning: checking %s: %v\n", r.Name, err)
+			continue
+		}
+		sessMgr := polecat.NewSessionManager(t, r)
+		for _, p := range found {
+			running, err := sessMgr.IsRunning(p.Name)
+			candidates = append(candidates, closedBeadPolecat{Rig: r.Name, ClosedBeadPolecat: p,
+				SessionRunning: running || err != nil, r: r, mgr: mgr})
+		}
+	}
+
+	if polecatReapJSON {
+		if candidates == nil {
+			candidates = []closedBeadPolecat{}
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(candidates)
+	}
+
+	if len(candidates) == 0 {
+		fmt.Printf("%s No polecats with closed agent beads.\n", style.SuccessPrefix)
+		return nil
+	}
+
+	fmt.Printf("%s %s with a closed agent bead:\n\n", style.Bold.Render("🪦"),
+		style.Count(len(candidates), "polecat", "polecats"))
+	reapable := 0
+	for _, c := range candidates {
+		line := fmt.Sprintf("  %s/%s (%s, bead %s %s)", c.Rig, c.Name, c.State, c.BeadID, c.BeadStatus)
+		if why := c.blocker(); why != "" {
+			if polecatReapForce {
+				line += " " + style.Warning.Render("- "+why)
+			} else {
+				line += " " + style.Dim.Render("- "+why+", skipped (use --force)")
+				fmt.Println(line)
+				continue
+			}
+		}
+		reapable++
+		fmt.Println(line)
+	}
+	fmt.Println()
+
+	if reapable == 0 {
+		fmt.Printf("%s Nothing to reap without --force.\n", style.Dim.Render("○"))
+		return nil
+	}
+	if polecatReapDryRun {
+		fmt.Printf("Would reap %s.\n", style.Count(reapable, "polecat", "polecats"))
+		return nil
+	}
+	if !polecatReapYes {
+		if !term.IsTerminal(int(os.Stdin.Fd())) {
+			fmt.Println("Re-run with --yes to reap them.")
+			return nil
+		}
+		if !promptYesNo(fmt.Sprintf("Reap %s?", style.Count(reapable, "polecat", "polecats"))) {
+			fmt.Println("Aborted.")
+			return nil
+		}
+	}
+
+	reaped, failed := 0, 0
+	for _, c := range candidates {
+		if c.blocker() != "" && !polecatReapForce {
+			continue
+		}
+		if err := stopPolecatSession(t, c.r, c.Name); err != nil {
+			fmt.Printf("  %s Skipped %s/%s: %v\n", style.Warning.Render("⚠"), c.Rig, c.Name, err)
+			failed++
+			continue
+		}
+		if err := c.mgr.RemoveWithOptions(c.Name, true, false); err != nil {
+			fmt.Printf("  %s Failed to reap %s/%s: %v\n", style.Error.Render("✗"), c.Rig, c.Name, err)
+			failed++
+			continue
+		}
+		fmt.Printf("  %s Reaped %s/%s\n", style.Success.Render("✓"), c.Rig, c.Name)
+		reaped++
+	}
+
+	fmt.Printf("\n%s Reaped %s.\n", style.SuccessPrefix, style.Count(reaped, "polecat", "polecats"))
+	if failed > 0 {
+		return fmt.Errorf("%s could not be reaped", style.Count(failed, "polecat", "polecats"))
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+var polecatShowJSON bool
+
+var polecatShowCmd = &cobra.Command{
+	Use:   "show <rig>/<polecat>",
+	Short: "Show everything known about a polecat",
+	Long: `Show everything gastown knows about one polecat - the inspection to run
+before nuking it.
+
+Gathers, from the polecat manager, tmux, git, and beads:
+  - State, assigned issue, branch, and worktree path
+  - Whether the branch is merged into the rig's default branch
+  - Uncommitted files, unpushed commits, stashes, and worktree locks
+  - Session status and PID
+  - Agent bead ID, status, labels, and hooked work
+  - Created, state-changed (done-at), and last-activity times
+
+Anything that can't be read is shown as unknown rather than failing.
+
+Examples:
+  gt polecat show greenplace/Toast
+  gt polecat show greenplace/Toast --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPolecatShow,
+}
+
+func init() {
+	polecatShowCmd.Flags().BoolVar(&polecatShowJSON, "json", false, "Output as JSON")
+
+	polecatCmd.AddCommand(polecatShowCmd)
+}
+
+// PolecatShow is the output of 'gt polecat show'.
+type PolecatShow struct {
+	*polecat.Details
+	Session    *polecat.SessionInfo `json:"session"`
+	SessionPID string               `json:"session_pid,omitempty"`
+	Git        *GitState            `json:"git,omitempty"` // Nil when the worktree can't be read
+}
+
+func runPolecatShow(cmd *cobra.Command, args []string) error {
+	rigName, polecatName, err := parseAddress(args[0])
+	if err != nil {
+		return err
+	}
+
+	mgr, r, err := getPolecatManager(rigName)
+	if err != nil {
+		return err
+	}
+
+	details, err := mgr.Inspect(polecatName)
+	if err != nil {
+		return fmt.Errorf("polecat '%s' not found in rig '%s'", polecatName, rigName)
+	}
+	show := &PolecatShow{Details: details}
+
+	t := tmux.NewTmux()
+	show.Session, err = polecat.NewSessionManager(t, r).Status(polecatName)
+	if err != nil {
+		show.Session = &polecat.SessionInfo{Polecat: polecatName, RigName: r.Name}
+	}
+	if show.Session.Running {
+		show.SessionPID, _ = t.GetPanePID(show.Session.SessionID)
+	}
+	if state, err := getGitState(details.ClonePath); err == nil {
+		show.Git = state
+	}
+
+	if polecatShowJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(show)
+	}
+
+	printPolecatShow(os.Stdout, show, time.Now())
+	return nil
+}
+
+// printPolecatShow renders 'gt polecat show' for humans.
+func printPolecatShow(w io.Writer, s *PolecatShow, now time.Time) {
+	unknown := style.Dim.Render("unknown")
+	when := func(t time.Time) string {
+		if t.IsZero() {
+			return unknown
+		}
+		return fmt.Sprintf("%s %s", t.Local().Format("2006-01-02 15:04"),
+			style.Dim.Render("("+style.HumanizeTimeAt(t, now)+")"))
+	}
+	orNone := func(v string) string {
+		if v == "" {
+			return style.Dim.Render("(none)")
+		}
+		return v
+	}
+
+	fmt.Fprintf(w, "%s\n\n", style.Bold.Render(fmt.Sprintf("Polecat: %s/%s", s.Rig, s.Name)))
+	fmt.Fprintf(w, "  State:         %s %s\n", polecatStateGlyph(s.State), s.State)
+	fmt.Fprintf(w, "  Issue:         %s\n", orNone(s.Issue))
+	fmt.Fprintf(w, "  Branch:        %s %s\n", orNone(s.Branch), style.Dim.Render("("+s.MergeStatus()+")"))
+	fmt.Fprintf(w, "  Worktree:      %s\n", displayPath(s.ClonePath))
+
+	fmt.Fprintf(w, "\n%s\n", style.Bold.Render("Git"))
+	if s.Git == nil {
+		fmt.Fprintf(w, "  Status:        %s\n", unknown)
+	} else {
+		status := style.Success.Render("clean")
+		if !s.Git.Clean {
+			status = style.Warning.Render(style.Count(len(s.Git.UncommittedFiles), "uncommitted file", "uncommitted files"))
+		}
+		fmt.Fprintf(w, "  Status:        %s\n", status)
+		fmt.Fprintf(w, "  Unpushed:      %s\n", style.Count(s.Git.UnpushedCommits, "commit", "commits"))
+		if s.Git.StashCount > 0 {
+			fmt.Fprintf(w, "  Stashes:       %d\n", s.Git.StashCount)
+		}
+	}
+	if len(s.WorktreeLocks) > 0 {
+		fmt.Fprintf(w, "  Locks:         %s\n", style.Warning.Render(strings.Join(s.WorktreeLocks, ", ")))
+	}
+
+	fmt.Fprintf(w, "\n%s\n", style.Bold.Render("Session"))
+	if s.Session.Running {
+		fmt.Fprintf(w, "  Status:        %s\n", style.Success.Render("running"))
+		fmt.Fprintf(w, "  Name:          %s\n", s.Session.SessionID)
+		if s.SessionPID != "" {
+			fmt.Fprintf(w, "  PID:           %s\n", s.SessionPID)
+		}
+		if s.Session.Attached {
+			fmt.Fprintf(w, "  Attached:      %s\n", style.Info.Render("yes"))
+		}
+	} else {
+		fmt.Fprintf(w, "  Status:        %s\n", style.Dim.Render("not running"))
+	}
+
+	fmt.Fprintf(w, "\n%s\n", style.Bold.Render("Agent bead"))
+	fmt.Fprintf(w, "  ID:            %s\n", s.BeadID)
+	switch {
+	case s.BeadError != "":
+		fmt.Fprintf(w, "  Status:        %s\n", style.Warning.Render("unreadable: "+s.BeadError))
+	case s.BeadStatus == "":
+		fmt.Fprintf(w, "  Status:        %s\n", style.Warning.Render("missing"))
+	default:
+		fmt.Fprintf(w, "  Status:        %s\n", s.BeadStatus)
+	}
+	if len(s.BeadLabels) > 0 {
+		fmt.Fprintf(w, "  Labels:        %s\n", strings.Join(s.BeadLabels, ", "))
+	}
+	fmt.Fprintf(w, "  Hooked:        %s\n", orNone(s.HookBead))
+
+	fmt.Fprintf(w, "\n%s\n", style.Bold.Render("Timestamps"))
+	fmt.Fprintf(w, "  Created:       %s\n", when(s.BeadCreated))
+	label := "State changed:"
+	if s.State == polecat.StateDone {
+		label = "Done:         "
+	}
+	fmt.Fprintf(w, "  %s %s\n", label, when(s.StateChangedAt))
+	fmt.Fprintf(w, "  Last activity: %s\n", when(s.Session.LastActivity))
+}
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/polecat"
+)
+
+func TestPrintPolecatShow(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	merged := true
+	show := &PolecatShow{
+		Details: &polecat.Details{
+			Polecat:        polecat.Polecat{Rig: "greenplace", Name: "Toast", State: polecat.StateDone, Branch: "polecat/Toast-1"},
+			BeadID:         "gt-greenplace-polecat-Toast",
+			BeadStatus:     "open",
+			BeadLabels:     []string{"pinned"},
+			StateChangedAt: now.Add(-3 * time.Hour),
+			BaseRef:        "origin/main",
+			BranchMerged:   &merged,
+		},
+		Session: &polecat.SessionInfo{Polecat: "Toast"},
+		Git:     &GitState{Clean: false, UncommittedFiles: []string{"a.go", "b.go"}, UnpushedCommits: 1},
+	}
+
+	var buf bytes.Buffer
+	printPolecatShow(&buf, show, now)
+	out := buf.String()
+
+	for _, want := range []string{
+		"Polecat: greenplace/Toast",
+		"merged into origin/main",
+		"2 uncommitted files",
+		"1 commit",
+		"not running",
+		"gt-greenplace-polecat-Toast",
+		"Labels:        pinned",
+		"Done:",
+		"(3 hours ago)",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+	if !strings.Contains(out, "Created:       unknown") {
+		t.Errorf("zero created time should render as unknown:\n%s", out)
+	}
+}
@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// polecatStateGlyph returns the tree glyph for a polecat state.
+func polecatStateGlyph(s polecat.State) string {
+	switch s {
+	case polecat.StateDone:
+		return style.Success.Render("✓")
+	case polecat.StateWorking:
+		return style.Info.Render("▶")
+	case polecat.StateStuck:
+		return style.Warning.Render("⚠")
+	default:
+		return style.Dim.Render("○")
+	}
+}
+
+// printPolecatTree renders 'gt polecat list --tree': polecats grouped under
+// their rigs (in rigs order, including rigs without polecats), each with a
+// state glyph, session indicator, and age. Done polecats are highlighted
+// since they are what 'gt cleanup' would reap.
+func printPolecatTree(w io.Writer, rigs []*rig.Rig, items []PolecatListItem, now time.Time) {
+	byRig := make(map[string][]PolecatListItem)
+	for _, p := range items {
+		byRig[p.Rig] = append(byRig[p.Rig], p)
+	}
+
+	var totalDone int
+	for i, r := range rigs {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		polecats := byRig[r.Name]
+		done := 0
+		for _, p := range polecats {
+			if p.State == polecat.StateDone {
+				done++
+			}
+		}
+		totalDone += done
+
+		counts := style.Count(len(polecats), "polecat", "polecats")
+		if done > 0 {
+			counts += fmt.Sprintf(", %d done", done)
+		}
+		fmt.Fprintf(w, "%s %s\n", style.Bold.Render(r.Name), style.Dim.Render("("+counts+")"))
+		if len(polecats) == 0 {
+			fmt.Fprintf(w, "└── %s\n", style.Dim.Render("(no polecats)"))
+			continue
+		}
+
+		nameWidth, stateWidth := 0, 0
+		for _, p := range polecats {
+			nameWidth = max(nameWidth, len(p.Name))
+			stateWidth = max(stateWidth, len(p.State))
+		}
+		for j, p := range polecats {
+			connector := "├──"
+			if j == len(polecats)-1 {
+				connector = "└──"
+			}
+
+			session := style.Dim.Render("○")
+			if p.SessionRunning {
+				session = style.Success.Render("●")
+			}
+			age := "-"
+			if !p.changedAt.IsZero() {
+				age = style.HumanizeDuration(now.Sub(p.changedAt))
+			}
+
+			name := fmt.Sprintf("%-*s", nameWidth, p.Name)
+			state := fmt.Sprintf("%-*s", stateWidth, p.State)
+			if p.State == polecat.StateDone {
+				name, state = style.Success.Render(name), style.Success.Render(state)
+			}
+			line := fmt.Sprintf("%s %s %s %s  %s %s", connector, polecatStateGlyph(p.State), session, name,
+				state, style.Dim.Render(age))
+			if p.Issue != "" {
+				line += "  " + style.Dim.Render(p.Issue)
+			}
+			fmt.Fprintln(w, line)
+		}
+	}
+
+	if totalDone > 0 {
+		fmt.Fprintf(w, "\n%s\n", style.Dim.Render(fmt.Sprintf("%s would be reaped by 'gt cleanup'",
+			style.Count(totalDone, "done polecat", "done polecats"))))
+	}
+}
@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+func TestPrintPolecatTree(t *testing.T) {
+	now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+	rigs := []*rig.Rig{{Name: "gastown"}, {Name: "beads"}}
+	items := []PolecatListItem{
+		{Rig: "gastown", Name: "Toast", State: polecat.StateWorking, Issue: "gt-abc", SessionRunning: true,
+			changedAt: now.Add(-2 * time.Hour)},
+		{Rig: "gastown", Name: "Nux", State: polecat.StateDone, changedAt: now.Add(-72 * time.Hour)},
+	}
+
+	var buf bytes.Buffer
+	printPolecatTree(&buf, rigs, items, now)
+	out := buf.String()
+
+	for _, want := range []string{
+		"gastown (2 polecats, 1 done)",
+		"├── ▶ ● Toast  working 2h  gt-abc",
+		"└── ✓ ○ Nux    done    3d",
+		"beads (0 polecats)",
+		"└── (no polecats)",
+		"1 done polecat would be reaped by 'gt cleanup'",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("tree missing %q:\n%s", want, out)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// RigArchiveMarkerFile is written to the rig root when a rig is archived.
+const RigArchiveMarkerFile = ".archived.json"
+
+var (
+	rigArchiveDryRun bool
+	rigArchiveForce  bool
+)
+
+var rigArchiveCmd = &cobra.Command{
+	Use:   "archive <rig>",
+	Short: "Tear down a rig's polecats and remove it from the registry",
+	Long: `Gracefully archive a rig.
+
+Archiving a rig:
+  - Reaps every polecat in the rig (kills session, removes worktree,
+    closes agent bead) using the same sequence as 'gt cleanup'
+  - Writes an archive marker (.archived.json) at the rig root
+  - Removes the rig from the registry (files are NOT deleted)
+
+Unlike 'gt rig remove', this does not leave polecat agent beads open.
+If any polecat fails to reap, the rig stays registered so you can retry.
+
+A polecat that is still working (not done) holds unfinished work, so the
+archive is refused while any remain. Use --force to reap them anyway.
+
+Examples:
+  gt rig archive gastown
+  gt rig archive gastown --dry-run   # Preview the teardown
+  gt rig archive gastown --force     # Also reap polecats still working`,
+	Args: cobra.ExactArgs(1),
+	RunE: runRigArchive,
+}
+
+// rigArchiveMarker records when a rig was archived and what was reaped.
+type rigArchiveMarker struct {
+	ArchivedAt time.Time `json:"archived_at"`
+	Polecats   []string  `json:"polecats,omitempty"`
+}
+
+func init() {
+	rigArchiveCmd.Flags().BoolVar(&rigArchiveDryRun, "dry-run", false, "Preview the teardown without making changes")
+	rigArchiveCmd.Flags().BoolVar(&rigArchiveForce, "force", false, "Archive even if polecats are still working")
+
+	rigCmd.AddCommand(rigArchiveCmd)
+}
+
+func runRigArchive(cmd *cobra.Command, args []string) error {
+	rigName := args[0]
+
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	rigsPath := constants.MayorRigsPath(townRoot)
+	rigsConfig, err := config.LoadRigsConfig(rigsPath)
+	if err != nil {
+		return fmt.Errorf("loading rigs config: %w", err)
+	}
+
+	rigMgr := rig.NewManager(townRoot, rigsConfig, git.NewGit(townRoot))
+	r, err := rigMgr.GetRig(rigName)
+	if err != nil {
+		return fmt.Errorf("rig '%s' not found", rigName)
+	}
+
+	mgr := polecat.NewManager(r, git.NewGit(r.Path))
+	polecats, err := mgr.List()
+	if err != nil {
+		return fmt.Errorf("listing polecats: %w", err)
+	}
+
+	var refusal error
+	if working := polecat.SelectNames(polecats, polecat.Not(polecat.IsDone)); len(working) > 0 && !rigArchiveForce {
+		refusal = fmt.Errorf("%s still working in %s (%s); let them finish or use --force",
+			style.Count(len(working), "polecat is", "polecats are"), rigName, strings.Join(working, ", "))
+	}
+
+	if rigArchiveDryRun {
+		fmt.Printf("%s Archive preview for %s (--dry-run)\n\n", style.Bold.Render("📦"), rigName)
+		if refusal != nil {
+			fmt.Printf("  %s Would refuse: %v\n", style.Warning.Render("⚠"), refusal)
+			return nil
+		}
+		for _, p := range polecats {
+			fmt.Printf("  Would reap: %s/%s (%s)\n", rigName, p.Name, p.State)
+		}
+		fmt.Printf("  Would write archive marker: %s\n", filepath.Join(r.Path, RigArchiveMarkerFile))
+		fmt.Printf("  Would remove %s from registry\n", rigName)
+		return nil
+	}
+	if refusal != nil {
+		return refusal
+	}
+
+	fmt.Printf("Archiving rig %s...\n", style.Bold.Render(rigName))
+
+	t := tmux.NewTmux()
+	var reaped []string
+	var failed int
+	for _, p := range polecats {
+		fmt.Printf("  Reaping %s/%s...", rigName, p.Name)
+		if err := reapPolecat(t, r, mgr, p.Name, "Rig archived by gt rig archive"); err != nil {
+			fmt.Printf(" %s (%v)\n", style.Error.Render("failed"), err)
+			failed++
+			continue
+		}
+		fmt.Printf(" %s\n", style.Success.Render("done"))
+		reaped = append(reaped, p.Name)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%s could not be reaped; %s left in registry", style.Count(failed, "polecat", "polecats"), rigName)
+	}
+
+	marker := rigArchiveMarker{ArchivedAt: time.Now().UTC(), Polecats: reaped}
+	data, err := json.MarshalIndent(marker, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding archive marker: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(r.Path, RigArchiveMarkerFile), data, 0644); err != nil {
+		return fmt.Errorf("writing archive marker: %w", err)
+	}
+
+	if err := rigMgr.RemoveRig(rigName); err != nil {
+		return fmt.Errorf("removing rig: %w", err)
+	}
+	if err := config.SaveRigsConfig(rigsPath, rigsConfig); err != nil {
+		return fmt.Errorf("saving rigs config: %w", err)
+	}
+
+	fmt.Printf("%s Rig %s archived (%s reaped)\n", style.Success.Render("✓"), rigName, style.Count(len(reaped), "polecat", "polecats"))
+	fmt.Printf("\nNote: Files at %s were NOT deleted.\n", r.Path)
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// setupTestTownForRigArchive creates a town with rigs rig-a and rig-b, where
+// rig-a has a done polecat Toast and a polecat Busy that is still working.
+// A mock bd assigns Busy an in-progress issue and logs every call. Returns
+// the town root and the bd log path.
+func setupTestTownForRigArchive(t *testing.T) (string, string) {
+	t.Helper()
+
+	townRoot := setupTestTownForCrewList(t, map[string][]string{"rig-a": nil, "rig-b": nil})
+	for _, name := range []string{"Toast", "Busy"} {
+		if err := os.MkdirAll(filepath.Join(townRoot, "rig-a", "polecats", name), 0755); err != nil {
+			t.Fatalf("mkdir polecat: %v", err)
+		}
+	}
+
+	binDir := t.TempDir()
+	logPath := filepath.Join(binDir, "bd.log")
+	script := `#!/bin/sh
+echo "$@" >> "` + logPath + `"
+case "$1" in
+  list)
+    case "$*" in
+      *Busy*) echo '[{"id":"ra-1","title":"Busy work","status":"in_progress"}]' ;;
+      *) echo '[]' ;;
+    esac
+    ;;
+  show)
+    exit 1
+    ;;
+esac
+exit 0
+`
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatalf("write mock bd: %v", err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	originalWd, _ := os.Getwd()
+	t.Cleanup(func() { _ = os.Chdir(originalWd) })
+	if err := os.Chdir(townRoot); err != nil {
+		t.Fatalf("chdir: %v", err)
+	}
+	return townRoot, logPath
+}
+
+func loadTestRigsConfig(t *testing.T, townRoot string) *config.RigsConfig {
+	t.Helper()
+	rigsConfig, err := config.LoadRigsConfig(filepath.Join(townRoot, "mayor", "rigs.json"))
+	if err != nil {
+		t.Fatalf("load rigs.json: %v", err)
+	}
+	return rigsConfig
+}
+
+func TestRigArchiveRefusesWorkingPolecats(t *testing.T) {
+	townRoot, _ := setupTestTownForRigArchive(t)
+
+	rigArchiveDryRun, rigArchiveForce = false, false
+	err := runRigArchive(&cobra.Command{}, []string{"rig-a"})
+	if err == nil {
+		t.Fatal("archive with a working polecat succeeded, want refusal")
+	}
+	if !strings.Contains(err.Error(), "Busy") || !strings.Contains(err.Error(), "--force") {
+		t.Errorf("error = %v, want it to name Busy and --force", err)
+	}
+
+	if _, ok := loadTestRigsConfig(t, townRoot).Rigs["rig-a"]; !ok {
+		t.Error("refused archive removed rig-a from rigs.json")
+	}
+	for _, name := range []string{"Toast", "Busy"} {
+		if _, err := os.Stat(filepath.Join(townRoot, "rig-a", "polecats", name)); err != nil {
+			t.Errorf("refused archive touched polecat %s: %v", name, err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(townRoot, "rig-a", RigArchiveMarkerFile)); !os.IsNotExist(err) {
+		t.Errorf("refused archive wrote a marker (stat err = %v)", err)
+	}
+}
+
+func TestRigArchiveForce(t *testing.T) {
+	townRoot, logPath := setupTestTownForRigArchive(t)
+
+	rigArchiveDryRun, rigArchiveForce = false, true
+	defer func() { rigArchiveForce = false }()
+	if err := runRigArchive(&cobra.Command{}, []string{"rig-a"}); err != nil {
+		t.Fatalf("runRigArchive --force: %v", err)
+	}
+
+	rigs := loadTestRigsConfig(t, townRoot).Rigs
+	if _, ok := rigs["rig-a"]; ok {
+		t.Error("rig-a still in rigs.json after archive")
+	}
+	if _, ok := rigs["rig-b"]; !ok {
+		t.Error("archiving rig-a removed rig-b from rigs.json")
+	}
+
+	for _, name := range []string{"Toast", "Busy"} {
+		if _, err := os.Stat(filepath.Join(townRoot, "rig-a", "polecats", name)); !os.IsNotExist(err) {
+			t.Errorf("polecat %s not reaped (stat err = %v)", name, err)
+		}
+	}
+
+	data, err := os.ReadFile(filepath.Join(townRoot, "rig-a", RigArchiveMarkerFile))
+	if err != nil {
+		t.Fatalf("archive marker: %v", err)
+	}
+	var marker rigArchiveMarker
+	if err := json.Unmarshal(data, &marker); err != nil {
+		t.Fatalf("parse archive marker: %v", err)
+	}
+	if strings.Join(marker.Polecats, ",") != "Busy,Toast" || marker.ArchivedAt.IsZero() {
+		t.Errorf("marker = %+v, want Busy and Toast reaped", marker)
+	}
+
+	log, err := os.ReadFile(logPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := strings.Count(string(log), "close "); n != 2 {
+		t.Errorf("bd close called %d times, want one per polecat agent bead:\n%s", n, log)
+	}
+}
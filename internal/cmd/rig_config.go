@@ -239,7 +239,7 @@ func getConfigKeys(townRoot string, r *rig.Rig) []string {
 		prefix = r.Config.Prefix
 	}
 	rigBeadID := beads.RigBeadIDWithPrefix(prefix, r.Name)
-	beadsDir := beads.ResolveBeadsDir(r.Path)
+	beadsDir := r.BeadsDir()
 	bd := beads.NewWithBeadsDir(townRoot, beadsDir)
 	if issue, err := bd.Show(rigBeadID); err == nil {
 		for _, label := range issue.Labels {
@@ -271,7 +271,7 @@ func setBeadLabel(townRoot string, r *rig.Rig, key, value string) error {
 	}
 
 	rigBeadID := beads.RigBeadIDWithPrefix(prefix, r.Name)
-	beadsDir := beads.ResolveBeadsDir(r.Path)
+	beadsDir := r.BeadsDir()
 	bd := beads.NewWithBeadsDir(townRoot, beadsDir)
 
 	// Check if bead exists
@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var rigDefaultBranchUnset bool
+
+var rigDefaultBranchCmd = &cobra.Command{
+	Use:   "default-branch <rig> [branch]",
+	Short: "Show or set a rig's default branch",
+	Long: `Show or set the default branch for a rig.
+
+The default branch is what branch GC and merged-detection compare against
+(e.g. 'gt polecat gc', 'gt cleanup --gc'). It is stored in the rig registry
+(mayor/rigs.json), which overrides default_branch in the rig's config.json.
+When neither sets it, the branch is auto-detected from origin/HEAD.
+
+Examples:
+  gt rig default-branch gastown            # Show configured/detected branch
+  gt rig default-branch gastown develop    # Set default branch to develop
+  gt rig default-branch gastown --unset    # Go back to auto-detection`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: runRigDefaultBranch,
+}
+
+func init() {
+	rigDefaultBranchCmd.Flags().BoolVar(&rigDefaultBranchUnset, "unset", false, "Clear the setting and auto-detect from origin/HEAD")
+
+	rigCmd.AddCommand(rigDefaultBranchCmd)
+}
+
+func runRigDefaultBranch(cmd *cobra.Command, args []string) error {
+	rigName := args[0]
+
+	if rigDefaultBranchUnset && len(args) > 1 {
+		return fmt.Errorf("cannot specify a branch with --unset")
+	}
+
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	rigsPath := constants.MayorRigsPath(townRoot)
+	rigsConfig, err := config.LoadRigsConfig(rigsPath)
+	if err != nil {
+		return fmt.Errorf("loading rigs config: %w", err)
+	}
+
+	mgr := rig.NewManager(townRoot, rigsConfig, git.NewGit(townRoot))
+	r, err := mgr.GetRig(rigName)
+	if err != nil {
+		return fmt.Errorf("rig '%s' not found", rigName)
+	}
+
+	// Show current setting
+	if len(args) == 1 && !rigDefaultBranchUnset {
+		branch, source := describeDefaultBranch(r)
+		fmt.Printf("%s: %s %s\n", rigName, style.Bold.Render(branch), style.Dim.Render("("+source+")"))
+		return nil
+	}
+
+	branch := ""
+	if len(args) > 1 {
+		branch = args[1]
+	}
+
+	if err := mgr.SetDefaultBranch(rigName, branch); err != nil {
+		return fmt.Errorf("setting default branch: %w", err)
+	}
+	if err := config.SaveRigsConfig(rigsPath, rigsConfig); err != nil {
+		return fmt.Errorf("saving rigs config: %w", err)
+	}
+
+	if branch == "" {
+		r.DefaultBranchName = ""
+		effective, source := describeDefaultBranch(r)
+		fmt.Printf("%s Cleared default branch for %s (now %s, %s)\n",
+			style.Success.Render("✓"), rigName, effective, source)
+	} else {
+		fmt.Printf("%s Default branch for %s set to %s\n", style.Success.Render("✓"), rigName, style.Bold.Render(branch))
+	}
+
+	return nil
+}
+
+// describeDefaultBranch returns the branch the polecat manager uses as r's
+// default and where it comes from, for display.
+func describeDefaultBranch(r *rig.Rig) (branch, source string) {
+	if branch, file := r.ConfiguredDefaultBranch(); branch != "" {
+		return branch, "configured in " + file
+	}
+	return r.DetectDefaultBranch(), "auto-detected from origin/HEAD"
+}
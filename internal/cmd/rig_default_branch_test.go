@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestRigDefaultBranchShowsConfigJSON(t *testing.T) {
+	townRoot := setupTestTownForCrewList(t, map[string][]string{"rig-a": nil})
+	configJSON := `{"type": "rig", "version": 1, "name": "rig-a", "default_branch": "develop"}`
+	if err := os.WriteFile(filepath.Join(townRoot, "rig-a", "config.json"), []byte(configJSON), 0644); err != nil {
+		t.Fatalf("write config.json: %v", err)
+	}
+
+	originalWd, _ := os.Getwd()
+	defer os.Chdir(originalWd)
+	if err := os.Chdir(townRoot); err != nil {
+		t.Fatalf("chdir: %v", err)
+	}
+	rigDefaultBranchUnset = false
+
+	show := func() string {
+		var err error
+		out := captureStdout(t, func() {
+			err = runRigDefaultBranch(&cobra.Command{}, []string{"rig-a"})
+		})
+		if err != nil {
+			t.Fatalf("runRigDefaultBranch: %v", err)
+		}
+		return out
+	}
+
+	// No registry setting: config.json's branch is what the polecat manager uses
+	if out := show(); !strings.Contains(out, "develop") || !strings.Contains(out, "config.json") {
+		t.Errorf("show = %q, want develop from config.json", out)
+	}
+
+	// The registry setting overrides config.json
+	captureStdout(t, func() {
+		if err := runRigDefaultBranch(&cobra.Command{}, []string{"rig-a", "release"}); err != nil {
+			t.Errorf("set default branch: %v", err)
+		}
+	})
+	if out := show(); !strings.Contains(out, "release") || !strings.Contains(out, "mayor/rigs.json") {
+		t.Errorf("show = %q, want release from mayor/rigs.json", out)
+	}
+}
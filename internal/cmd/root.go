@@ -201,6 +201,7 @@ func init() {
 
 	// Global flags can be added here
 	// rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file")
+	rootCmd.PersistentFlags().BoolVar(&absolutePaths, "absolute-paths", false, "Show full paths instead of paths relative to the town root")
 }
 
 // buildCommandPath walks the command hierarchy to build the full command path.
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -201,12 +202,15 @@ func init() {
 	rootCmd.AddCommand(sessionCmd)
 }
 
-// parseAddress parses "rig/polecat" format.
-// If no "/" is present, attempts to infer rig from current directory.
+// parseAddress parses "rig/polecat" format. Polecat names have no slash, so
+// the rig is everything before the last one and may be nested
+// ("outer/inner/polecat"), as in polecat.Resolve.
+// If no "/" is present, attempts to infer rig from current directory, then
+// looks the bare name up across all rigs (see polecat.Resolve). A bare name
+// that exists in several rigs is an error listing the candidates.
 func parseAddress(addr string) (rigName, polecatName string, err error) {
-	parts := strings.SplitN(addr, "/", 2)
-	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
-		return parts[0], parts[1], nil
+	if i := strings.LastIndex(addr, "/"); i > 0 && i < len(addr)-1 {
+		return addr[:i], addr[i+1:], nil
 	}
 
 	// No slash - try to infer rig from cwd
@@ -217,6 +221,18 @@ func parseAddress(addr string) (rigName, polecatName string, err error) {
 			if err == nil && inferredRig != "" {
 				return inferredRig, addr, nil
 			}
+
+			// Not inside a rig - find the rig that has this polecat
+			if rigs, _, err := getAllRigs(); err == nil {
+				r, name, err := polecat.Resolve(rigs, addr)
+				var ambiguous *polecat.AmbiguousNameError
+				if errors.As(err, &ambiguous) {
+					return "", "", err
+				}
+				if err == nil {
+					return r.Name, name, nil
+				}
+			}
 		}
 	}
 
@@ -653,7 +669,7 @@ func runSessionCheck(cmd *cobra.Command, args []string) error {
 				continue
 			}
 			polecatName := entry.Name()
-			sessionName := fmt.Sprintf("gt-%s-%s", r.Name, polecatName)
+			sessionName := polecat.SessionName(r.Name, polecatName)
 			totalChecked++
 
 			// Check if session exists
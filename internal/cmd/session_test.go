@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"os"
+	"testing"
+)
+
+func TestParseAddressNestedRig(t *testing.T) {
+	townRoot := setupTestTownForCrewList(t, map[string][]string{"outer/inner": nil})
+	originalWd, _ := os.Getwd()
+	defer os.Chdir(originalWd)
+	if err := os.Chdir(townRoot); err != nil {
+		t.Fatal(err)
+	}
+
+	rigName, polecatName, err := parseAddress("outer/inner/Toast")
+	if err != nil {
+		t.Fatalf("parseAddress: %v", err)
+	}
+	if rigName != "outer/inner" || polecatName != "Toast" {
+		t.Fatalf("parseAddress = %q, %q; want outer/inner, Toast", rigName, polecatName)
+	}
+
+	// The rig half is what polecat show, peek, nudge and session look up
+	_, r, err := getPolecatManager(rigName)
+	if err != nil {
+		t.Fatalf("getPolecatManager(%q): %v", rigName, err)
+	}
+	if r.Name != "outer/inner" {
+		t.Errorf("rig = %q, want outer/inner", r.Name)
+	}
+
+	for _, bad := range []string{"outer/inner/", "/Toast"} {
+		if _, _, err := parseAddress(bad); err == nil {
+			t.Errorf("parseAddress(%q) succeeded, want an error", bad)
+		}
+	}
+}
@@ -0,0 +1,324 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	townGCDryRun bool
+	townGCJSON   bool
+	townGCYes    bool
+)
+
+var townGCCmd = &cobra.Command{
+	Use:   "gc",
+	Short: "Run all town maintenance in one pass",
+	Long: `Run every cleanup and maintenance phase across all rigs in one pass.
+
+Phases run in dependency order:
+  1. Reap done polecats         (as 'gt cleanup --polecats')
+  2. Prune stale worktree entries left by deleted polecat directories
+  3. GC merged polecat branches (as 'gt cleanup --gc')
+  4. git gc each rig's repository
+  5. Sweep orphans: kill polecat sessions and close open polecat agent
+     beads whose polecat directory no longer exists (see 'gt polecat fsck')
+  6. Close completed convoys     (as 'gt cleanup --convoys')
+
+Use --dry-run to preview every phase without changing anything. A unified
+summary is printed at the end; --json prints it as JSON.
+
+Cleanup's safety defaults apply: the mass-cleanup circuit breaker (use --yes
+to confirm), nested rigs are skipped, locked worktrees are skipped. Bead
+closes are recorded in logs/cleanup.jsonl and can be reopened with
+'gt cleanup --undo'.
+
+Examples:
+  gt town gc --dry-run   # Preview all phases
+  gt town gc             # Weekly maintenance
+  gt town gc --json      # Unified summary as JSON (progress goes to stderr)`,
+	RunE: runTownGC,
+}
+
+func init() {
+	townGCCmd.Flags().BoolVar(&townGCDryRun, "dry-run", false, "Preview every phase without changing anything")
+	townGCCmd.Flags().BoolVar(&townGCJSON, "json", false, "Output summary as JSON")
+	townGCCmd.Flags().BoolVarP(&townGCYes, "yes", "y", false, "Confirm an anomalously large polecat cleanup")
+
+	townCmd.AddCommand(townGCCmd)
+}
+
+// townGCSummary is the unified result of a town gc run.
+type townGCSummary struct {
+	DryRun          bool             `json:"dry_run"`
+	PolecatsNuked   int              `json:"polecats_nuked"`
+	BytesReclaimed  int64            `json:"bytes_reclaimed"`
+	WorktreesPruned int              `json:"worktrees_pruned"`
+	BranchesGCed    int              `json:"branches_gced"`
+	ReposGCed       int              `json:"repos_gced"`
+	OrphanSessions  int              `json:"orphan_sessions_killed"`
+	OrphanBeads     int              `json:"orphan_beads_closed"`
+	ConvoysClosed   int              `json:"convoys_closed"`
+	Interrupted     bool             `json:"interrupted,omitempty"` // Cancelled before every phase ran; totals are partial
+	Warnings        []CleanupWarning `json:"warnings"`
+}
+
+func runTownGC(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	rigs, nested, err := discoverCleanupRigs(townRoot, true)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := interruptContext(cmd)
+	defer stop()
+
+	var progress io.Writer = os.Stdout
+	if townGCJSON {
+		progress = os.Stderr
+	}
+	cleanupOut = bufio.NewWriter(progress)
+	defer func() {
+		flushCleanupOut()
+		cleanupOut = os.Stdout
+	}()
+
+	restoreFlags := useTownGCCleanupFlags()
+	defer restoreFlags()
+
+	dryRun := townGCDryRun
+	warnings := &cleanupWarnings{}
+	var audit *cleanupAuditEntry
+	if !dryRun {
+		audit = &cleanupAuditEntry{Timestamp: time.Now().UTC(), Actor: detectSender()}
+	}
+	summary := townGCSummary{DryRun: dryRun}
+
+	if dryRun {
+		fmt.Fprintf(cleanupOut, "%s Town gc preview (--dry-run)\n\n", style.Bold.Render("🧹"))
+	} else {
+		fmt.Fprintf(cleanupOut, "%s Town gc\n\n", style.Bold.Render("🧹"))
+	}
+	printSkippedNestedRigs(nested)
+
+	// Each phase runs only if no interrupt has arrived; an interrupted phase
+	// finishes its in-flight item and the remaining phases are skipped.
+	phase := func(name string, run func()) {
+		if ctx.Err() == nil {
+			townGCPhase(name)
+			run()
+		}
+	}
+
+	var reapErr error
+	phase("Reap done polecats", func() {
+		var reaped cleanupPolecatsResult
+		reaped, reapErr = cleanupDonePolecats(ctx, townRoot, rigs, dryRun, 0, warnings, audit)
+		summary.PolecatsNuked, summary.BytesReclaimed = reaped.Nuked, reaped.Reclaimed
+		if reapErr != nil && !errors.Is(reapErr, errTooManyCleanupTargets) && ctx.Err() == nil {
+			warnings.add("", "polecat cleanup had errors: %v", reapErr)
+		}
+	})
+	if errors.Is(reapErr, errTooManyCleanupTargets) {
+		return reapErr
+	}
+
+	phase("Prune worktrees", func() {
+		summary.WorktreesPruned = pruneRigWorktrees(rigs, dryRun, warnings)
+	})
+	phase("GC branches", func() {
+		summary.BranchesGCed, _ = cleanupStaleBranches(ctx, rigs, dryRun, warnings)
+	})
+	phase("git gc", func() {
+		summary.ReposGCed = gcRigRepos(rigs, dryRun, warnings)
+	})
+	phase("Sweep orphans", func() {
+		summary.OrphanSessions, summary.OrphanBeads = sweepRigOrphans(rigs, dryRun, warnings, audit)
+	})
+	phase("Close convoys", func() {
+		var err error
+		summary.ConvoysClosed, err = cleanupCompletedConvoys(ctx, filepath.Join(townRoot, ".beads"), dryRun, nil, "", warnings, audit)
+		if err != nil && ctx.Err() == nil {
+			warnings.add("", "convoy cleanup had errors: %v", err)
+		}
+	})
+	summary.Interrupted = ctx.Err() != nil
+
+	if audit != nil && len(audit.Actions) > 0 {
+		if err := appendCleanupAudit(townRoot, audit); err != nil {
+			warnings.add("", "could not write audit log: %v", err)
+		}
+	}
+
+	summary.Warnings = warnings.items
+	if summary.Warnings == nil {
+		summary.Warnings = []CleanupWarning{}
+	}
+
+	if townGCJSON {
+		flushCleanupOut()
+		data, err := json.MarshalIndent(summary, "", "  ")
+		if err != nil {
+			return fmt.Errorf("encoding summary: %w", err)
+		}
+		if _, err := os.Stdout.Write(append(data, '\n')); err != nil {
+			return err
+		}
+		if summary.Interrupted {
+			return NewSilentExit(130)
+		}
+		return nil
+	}
+
+	printTownGCSummary(summary)
+	warnings.print()
+	if summary.Interrupted {
+		return NewSilentExit(130)
+	}
+	return nil
+}
+
+// useTownGCCleanupFlags points the cleanup flags read by the shared polecat
+// phase (the circuit breaker's --yes and --json) at town gc's own flags. It
+// returns a func that restores them.
+func useTownGCCleanupFlags() (restore func()) {
+	yes, jsonOut := cleanupYes, cleanupJSON
+	cleanupYes, cleanupJSON = townGCYes, townGCJSON
+	return func() { cleanupYes, cleanupJSON = yes, jsonOut }
+}
+
+// townGCPhase prints a phase header.
+func townGCPhase(name string) {
+	fmt.Fprintf(cleanupOut, "\n%s\n", style.Bold.Render("── "+name+" ──"))
+	flushCleanupOut()
+}
+
+// printTownGCSummary renders the unified summary.
+func printTownGCSummary(s townGCSummary) {
+	verb := "Town gc complete:"
+	if s.Interrupted {
+		verb = "Town gc interrupted; remaining phases skipped, totals are partial:"
+	} else if s.DryRun {
+		verb = "Dry run complete. Would clean:"
+	}
+	fmt.Fprintf(cleanupOut, "\n%s %s\n", style.Bold.Render("✓"), verb)
+
+	reaped := style.Count(s.PolecatsNuked, "polecat", "polecats") + " nuked"
+	if s.BytesReclaimed > 0 {
+		reaped += fmt.Sprintf(" (%s reclaimed)", formatBytes(s.BytesReclaimed))
+	}
+	for _, line := range []string{
+		reaped,
+		style.Count(s.WorktreesPruned, "worktree entry", "worktree entries") + " pruned",
+		style.Count(s.BranchesGCed, "branch", "branches") + " gc'd",
+		style.Count(s.ReposGCed, "repo", "repos") + " gc'd",
+		style.Count(s.OrphanSessions, "orphan session", "orphan sessions") + " killed",
+		style.Count(s.OrphanBeads, "orphan agent bead", "orphan agent beads") + " closed",
+		style.Count(s.ConvoysClosed, "convoy", "convoys") + " closed",
+	} {
+		fmt.Fprintf(cleanupOut, "  - %s\n", line)
+	}
+}
+
+// pruneRigWorktrees prunes stale worktree entries in every rig's repo base.
+func pruneRigWorktrees(rigs []*rig.Rig, dryRun bool, warnings *cleanupWarnings) int {
+	var total atomic.Int64
+	forEachRig(rigs, cleanupJobs, func(r *rig.Rig, out io.Writer) {
+		mgr := polecat.NewManager(r, git.NewGit(r.Path))
+		pruned, err := mgr.PruneWorktrees(dryRun)
+		if err != nil {
+			warnings.add(r.Name, "worktree prune failed: %v", err)
+			return
+		}
+		for _, path := range pruned {
+			if dryRun {
+				fmt.Fprintf(out, "  Would prune worktree: %s (%s)\n", displayPath(path), r.Name)
+			} else {
+				fmt.Fprintf(out, "  Pruned worktree: %s (%s)\n", displayPath(path), r.Name)
+			}
+		}
+		total.Add(int64(len(pruned)))
+	})
+	return int(total.Load())
+}
+
+// gcRigRepos runs git gc on every rig's repo base.
+func gcRigRepos(rigs []*rig.Rig, dryRun bool, warnings *cleanupWarnings) int {
+	var total atomic.Int64
+	forEachRig(rigs, cleanupJobs, func(r *rig.Rig, out io.Writer) {
+		if dryRun {
+			fmt.Fprintf(out, "  Would git gc: %s\n", r.Name)
+			total.Add(1)
+			return
+		}
+		mgr := polecat.NewManager(r, git.NewGit(r.Path))
+		if err := mgr.GCRepo(); err != nil {
+			warnings.add(r.Name, "git gc failed: %v", err)
+			return
+		}
+		fmt.Fprintf(out, "  git gc'd %s\n", r.Name)
+		total.Add(1)
+	})
+	return int(total.Load())
+}
+
+// sweepRigOrphans kills polecat sessions and closes open polecat agent beads
+// whose polecat directory no longer exists, as found by polecat fsck.
+// Closed beads are recorded in audit (nil in dry-run).
+func sweepRigOrphans(rigs []*rig.Rig, dryRun bool, warnings *cleanupWarnings, audit *cleanupAuditEntry) (int, int) {
+	var sessions, beadsClosed atomic.Int64
+	t := tmux.NewTmux()
+	forEachRig(rigs, cleanupJobs, func(r *rig.Rig, out io.Writer) {
+		mgr := polecat.NewManager(r, git.NewGit(r.Path))
+		report, err := mgr.Fsck(polecat.NewSessionManager(t, r))
+		if err != nil {
+			warnings.add(r.Name, "orphan scan failed: %v", err)
+			return
+		}
+
+		for _, s := range report.OrphanSessions {
+			if dryRun {
+				fmt.Fprintf(out, "  Would kill orphan session: %s\n", s)
+			} else if err := t.KillSession(s); err != nil {
+				warnings.add(r.Name, "failed to kill orphan session %s: %v", s, err)
+				continue
+			} else {
+				fmt.Fprintf(out, "  Killed orphan session: %s\n", s)
+			}
+			sessions.Add(1)
+		}
+
+		for _, id := range report.OrphanBeads {
+			if dryRun {
+				fmt.Fprintf(out, "  Would close orphan agent bead: %s\n", id)
+			} else if err := closeRigBead(r, id, "Orphaned: polecat directory gone (gt town gc)"); err != nil {
+				warnings.add(r.Name, "failed to close orphan bead %s: %v", id, err)
+				continue
+			} else {
+				fmt.Fprintf(out, "  Closed orphan agent bead: %s\n", id)
+				audit.record(cleanupActionAgentBeadClosed, r.Name, id)
+			}
+			beadsClosed.Add(1)
+		}
+	})
+	return int(sessions.Load()), int(beadsClosed.Load())
+}
@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestUseTownGCCleanupFlags(t *testing.T) {
+	oldOut, oldMax := cleanupOut, cleanupMaxTargets
+	oldYes, oldJSON, oldForce := townGCYes, townGCJSON, cleanupForce
+	defer func() {
+		cleanupOut, cleanupMaxTargets = oldOut, oldMax
+		townGCYes, townGCJSON, cleanupForce = oldYes, oldJSON, oldForce
+		cleanupYes, cleanupJSON = false, false
+	}()
+	cleanupOut = &bytes.Buffer{}
+	cleanupMaxTargets = 10
+	cleanupForce = false
+
+	// gt town gc --yes --json confirms the polecat phase's circuit breaker
+	cleanupYes, cleanupJSON = false, false
+	townGCYes, townGCJSON = true, true
+	restore := useTownGCCleanupFlags()
+	if !cleanupYes || !cleanupJSON {
+		t.Errorf("cleanupYes = %v, cleanupJSON = %v; want both mapped from town gc", cleanupYes, cleanupJSON)
+	}
+	if err := checkCleanupTargets(50, 50, false); err != nil {
+		t.Errorf("circuit breaker under town gc --yes: %v", err)
+	}
+
+	restore()
+	if cleanupYes || cleanupJSON {
+		t.Errorf("cleanupYes = %v, cleanupJSON = %v after restore; want false", cleanupYes, cleanupJSON)
+	}
+	if err := checkCleanupTargets(50, 50, false); !errors.Is(err, errTooManyCleanupTargets) {
+		t.Errorf("circuit breaker after restore = %v, want errTooManyCleanupTargets", err)
+	}
+}
+
+func TestRunTownGCDryRunJSON(t *testing.T) {
+	townRoot := setupTestTownForCrewList(t, nil)
+	if err := os.MkdirAll(filepath.Join(townRoot, ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	binDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte("#!/bin/sh\necho '[]'\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	originalWd, _ := os.Getwd()
+	defer os.Chdir(originalWd)
+	if err := os.Chdir(townRoot); err != nil {
+		t.Fatalf("chdir: %v", err)
+	}
+
+	oldDryRun, oldJSON, oldYes := townGCDryRun, townGCJSON, townGCYes
+	defer func() { townGCDryRun, townGCJSON, townGCYes = oldDryRun, oldJSON, oldYes }()
+	townGCDryRun, townGCJSON, townGCYes = true, true, true
+	cleanupYes, cleanupJSON = false, false
+
+	var runErr error
+	out := captureStdout(t, func() {
+		runErr = runTownGC(&cobra.Command{}, nil)
+	})
+	if runErr != nil {
+		t.Fatalf("runTownGC: %v", runErr)
+	}
+
+	var summary townGCSummary
+	if err := json.Unmarshal([]byte(out), &summary); err != nil {
+		t.Fatalf("stdout is not the JSON summary: %v\n%s", err, out)
+	}
+	if !summary.DryRun || summary.Interrupted || len(summary.Warnings) != 0 {
+		t.Errorf("summary = %+v, want a clean dry run", summary)
+	}
+	if cleanupYes || cleanupJSON {
+		t.Errorf("town gc leaked cleanupYes = %v, cleanupJSON = %v", cleanupYes, cleanupJSON)
+	}
+}
@@ -71,7 +71,8 @@ func SaveTownConfig(path string, config *TownConfig) error {
 	return nil
 }
 
-// LoadRigsConfig loads and validates a rigs registry file.
+// LoadRigsConfig loads and validates a rigs registry file. A personal
+// override layer next to it (see LocalRigsConfigPath) is merged over it.
 func LoadRigsConfig(path string) (*RigsConfig, error) {
 	data, err := os.ReadFile(path) //nolint:gosec // G304: path is constructed internally, not from user input
 	if err != nil {
@@ -81,6 +82,15 @@ func LoadRigsConfig(path string) (*RigsConfig, error) {
 		return nil, fmt.Errorf("reading config: %w", err)
 	}
 
+	config, err := parseRigsConfig(data)
+	if err != nil {
+		return nil, err
+	}
+	return applyLocalRigsLayer(path, data, config)
+}
+
+// parseRigsConfig decodes, validates, and migrates a rigs registry.
+func parseRigsConfig(data []byte) (*RigsConfig, error) {
 	var config RigsConfig
 	if err := json.Unmarshal(data, &config); err != nil {
 		return nil, fmt.Errorf("parsing config: %w", err)
@@ -89,11 +99,15 @@ func LoadRigsConfig(path string) (*RigsConfig, error) {
 	if err := validateRigsConfig(&config); err != nil {
 		return nil, err
 	}
+	if err := migrateRigsConfig(&config); err != nil {
+		return nil, err
+	}
 
 	return &config, nil
 }
 
-// SaveRigsConfig saves a rigs registry to a file.
+// SaveRigsConfig saves a rigs registry to a file. For a registry loaded with
+// a local layer, the layer's overrides are not written to the shared file.
 func SaveRigsConfig(path string, config *RigsConfig) error {
 	if err := validateRigsConfig(config); err != nil {
 		return err
@@ -103,7 +117,7 @@ func SaveRigsConfig(path string, config *RigsConfig) error {
 		return fmt.Errorf("creating directory: %w", err)
 	}
 
-	data, err := json.MarshalIndent(config, "", "  ")
+	data, err := marshalRigsConfig(config)
 	if err != nil {
 		return fmt.Errorf("encoding config: %w", err)
 	}
@@ -0,0 +1,143 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+)
+
+// rigsLayer remembers how a RigsConfig was assembled from the shared file and
+// a local override layer, so saving writes back only the caller's changes
+// and never copies personal overrides into the shared file.
+type rigsLayer struct {
+	base   map[string]interface{} // The shared file alone, as saved
+	merged map[string]interface{} // The merged config as loaded
+}
+
+// LocalRigsConfigPath returns the path of the personal override layer for a
+// rigs registry: mayor/rigs.local.json next to mayor/rigs.json. The local
+// file is not meant to be committed; its keys win over the shared file's.
+func LocalRigsConfigPath(path string) string {
+	return strings.TrimSuffix(path, ".json") + ".local.json"
+}
+
+// mergeRigsLayers deep-merges the local layer over the shared file's JSON:
+// objects merge key by key, anything else in the local layer replaces the
+// shared value.
+func mergeRigsLayers(base, local []byte) ([]byte, error) {
+	var b, l map[string]interface{}
+	if err := json.Unmarshal(base, &b); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(local, &l); err != nil {
+		return nil, err
+	}
+	return json.Marshal(mergeJSONObjects(b, l))
+}
+
+func mergeJSONObjects(base, over map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(base)+len(over))
+	for k, v := range base {
+		out[k] = v
+	}
+	for k, v := range over {
+		bo, bok := out[k].(map[string]interface{})
+		oo, ook := v.(map[string]interface{})
+		if bok && ook {
+			out[k] = mergeJSONObjects(bo, oo)
+		} else {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// unmergeJSONObjects is a three-way merge for saving a layered config: keys
+// the caller left as loaded (equal in current and merged) take the shared
+// file's value, or are omitted if the shared file didn't have them; keys the
+// caller added, changed, or removed are written as they now are.
+func unmergeJSONObjects(current, merged, base map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{})
+	for k, cur := range current {
+		was, loaded := merged[k]
+		b, inBase := base[k]
+		co, cok := cur.(map[string]interface{})
+		wo, wok := was.(map[string]interface{})
+		switch {
+		case loaded && cok && wok:
+			bo, _ := b.(map[string]interface{})
+			sub := unmergeJSONObjects(co, wo, bo)
+			if len(sub) > 0 || inBase {
+				out[k] = sub
+			}
+		case loaded && reflect.DeepEqual(cur, was):
+			if inBase {
+				out[k] = b
+			}
+		default:
+			out[k] = cur
+		}
+	}
+	return out
+}
+
+// toJSONObject round-trips v through JSON into a generic object.
+func toJSONObject(v interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// applyLocalRigsLayer merges the local layer at LocalRigsConfigPath(path),
+// if there is one, over the shared file (data, parsed as base) and returns
+// the merged config. Without a local layer, base is returned unchanged.
+func applyLocalRigsLayer(path string, data []byte, base *RigsConfig) (*RigsConfig, error) {
+	localPath := LocalRigsConfigPath(path)
+	local, err := os.ReadFile(localPath) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		if os.IsNotExist(err) {
+			return base, nil
+		}
+		return nil, fmt.Errorf("reading local config: %w", err)
+	}
+
+	mergedData, err := mergeRigsLayers(data, local)
+	if err != nil {
+		return nil, fmt.Errorf("parsing local config %s: %w", localPath, err)
+	}
+	merged, err := parseRigsConfig(mergedData)
+	if err != nil {
+		return nil, fmt.Errorf("local config %s: %w", localPath, err)
+	}
+
+	layer := &rigsLayer{}
+	if layer.base, err = toJSONObject(base); err != nil {
+		return nil, err
+	}
+	if layer.merged, err = toJSONObject(merged); err != nil {
+		return nil, err
+	}
+	merged.layer = layer
+	return merged, nil
+}
+
+// marshalRigsConfig encodes c for saving. A config loaded with a local layer
+// is reduced to the shared file plus the caller's changes.
+func marshalRigsConfig(c *RigsConfig) ([]byte, error) {
+	if c.layer == nil {
+		return json.MarshalIndent(c, "", "  ")
+	}
+	current, err := toJSONObject(c)
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(unmergeJSONObjects(current, c.layer.merged, c.layer.base), "", "  ")
+}
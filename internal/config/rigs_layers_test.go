@@ -0,0 +1,86 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeRigsLayers(t *testing.T, shared, local string) string {
+	t.Helper()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "rigs.json")
+	if err := os.WriteFile(path, []byte(shared), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if local != "" {
+		if err := os.WriteFile(LocalRigsConfigPath(path), []byte(local), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return path
+}
+
+func TestLoadRigsConfigMergesLocalLayer(t *testing.T) {
+	path := writeRigsLayers(t,
+		`{"version":1,"rigs":{"gastown":{"git_url":"x","priority":1}},"cleanup_defaults":{"jobs":"2","since":"24h"}}`,
+		`{"rigs":{"gastown":{"priority":9}},"cleanup_defaults":{"jobs":"8"}}`)
+
+	c, err := LoadRigsConfig(path)
+	if err != nil {
+		t.Fatalf("LoadRigsConfig: %v", err)
+	}
+	if r := c.Rigs["gastown"]; r.GitURL != "x" || r.Priority != 9 {
+		t.Errorf("gastown = %+v, want git_url from shared file and priority from local layer", r)
+	}
+	if c.CleanupDefaults["jobs"] != "8" || c.CleanupDefaults["since"] != "24h" {
+		t.Errorf("CleanupDefaults = %v, want jobs=8 (local) and since=24h (shared)", c.CleanupDefaults)
+	}
+}
+
+func TestSaveRigsConfigKeepsLocalLayerOut(t *testing.T) {
+	path := writeRigsLayers(t,
+		`{"version":1,"rigs":{"gastown":{"git_url":"x","priority":1}}}`,
+		`{"rigs":{"gastown":{"priority":9},"scratch":{"git_url":"mine"}},"cleanup_defaults":{"jobs":"8"}}`)
+
+	c, err := LoadRigsConfig(path)
+	if err != nil {
+		t.Fatalf("LoadRigsConfig: %v", err)
+	}
+	c.Rigs["beads"] = RigEntry{GitURL: "y"}
+	if err := SaveRigsConfig(path, c); err != nil {
+		t.Fatalf("SaveRigsConfig: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, leaked := range []string{"scratch", "mine", "cleanup_defaults", `"priority": 9`} {
+		if strings.Contains(string(data), leaked) {
+			t.Errorf("local override %q written to shared file:\n%s", leaked, data)
+		}
+	}
+
+	if err := os.Remove(LocalRigsConfigPath(path)); err != nil {
+		t.Fatal(err)
+	}
+	shared, err := LoadRigsConfig(path)
+	if err != nil {
+		t.Fatalf("reloading shared file: %v", err)
+	}
+	if shared.Rigs["gastown"].Priority != 1 {
+		t.Errorf("gastown priority = %d, want shared value 1", shared.Rigs["gastown"].Priority)
+	}
+	if shared.Rigs["beads"].GitURL != "y" {
+		t.Errorf("rig added by the caller was not saved: %+v", shared.Rigs)
+	}
+}
+
+func TestLoadRigsConfigInvalidLocalLayer(t *testing.T) {
+	path := writeRigsLayers(t, `{"version":1,"rigs":{}}`, `{not json`)
+	if _, err := LoadRigsConfig(path); err == nil || !strings.Contains(err.Error(), "rigs.local.json") {
+		t.Errorf("err = %v, want an error naming rigs.local.json", err)
+	}
+}
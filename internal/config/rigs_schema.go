@@ -0,0 +1,167 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ErrUnknownField indicates a config key this binary does not understand.
+var ErrUnknownField = errors.New("unknown config field")
+
+// UnknownKeyPolicy controls how LoadRigsConfigWithPolicy treats keys in
+// rigs.json that this binary does not know about (typically written by a
+// newer gt).
+type UnknownKeyPolicy int
+
+const (
+	// UnknownKeysPreserve keeps unknown keys and writes them back unchanged
+	// on save, so rewriting the file never drops them. This is the default.
+	UnknownKeysPreserve UnknownKeyPolicy = iota
+
+	// UnknownKeysReject fails the load with ErrUnknownField.
+	UnknownKeysReject
+)
+
+// rigsConfigMigrations upgrade a RigsConfig one schema version at a time:
+// rigsConfigMigrations[v] migrates version v to v+1. CurrentRigsVersion must
+// equal len(rigsConfigMigrations).
+var rigsConfigMigrations = []func(c *RigsConfig) error{
+	// 0 -> 1: files written before the version field existed. The layout
+	// is unchanged; only the version is stamped.
+	func(c *RigsConfig) error { return nil },
+}
+
+// migrateRigsConfig upgrades c in place to CurrentRigsVersion.
+func migrateRigsConfig(c *RigsConfig) error {
+	for c.Version < CurrentRigsVersion {
+		if err := rigsConfigMigrations[c.Version](c); err != nil {
+			return fmt.Errorf("migrating rigs config from version %d: %w", c.Version, err)
+		}
+		c.Version++
+	}
+	return nil
+}
+
+// LoadRigsConfigWithPolicy is LoadRigsConfig with an explicit policy for
+// unknown keys.
+func LoadRigsConfigWithPolicy(path string, policy UnknownKeyPolicy) (*RigsConfig, error) {
+	c, err := LoadRigsConfig(path)
+	if err != nil {
+		return nil, err
+	}
+	if policy == UnknownKeysReject {
+		if unknown := c.UnknownFields(); len(unknown) > 0 {
+			return nil, fmt.Errorf("%w in %s: %s", ErrUnknownField, path, strings.Join(unknown, ", "))
+		}
+	}
+	return c, nil
+}
+
+// UnknownFields lists the keys this binary does not understand, top-level
+// ones by name and per-rig ones as "rigs.<name>.<key>", sorted.
+func (c *RigsConfig) UnknownFields() []string {
+	var fields []string
+	for key := range c.Extra {
+		fields = append(fields, key)
+	}
+	for name, entry := range c.Rigs {
+		for key := range entry.Extra {
+			fields = append(fields, "rigs."+name+"."+key)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// UnmarshalJSON decodes a RigsConfig, keeping unknown keys in Extra.
+func (c *RigsConfig) UnmarshalJSON(data []byte) error {
+	type plain RigsConfig
+	var p plain
+	if err := json.Unmarshal(data, &p); err != nil {
+		return err
+	}
+	extra, err := unknownJSONFields(data, reflect.TypeOf(p))
+	if err != nil {
+		return err
+	}
+	p.Extra = extra
+	*c = RigsConfig(p)
+	return nil
+}
+
+// MarshalJSON encodes a RigsConfig, writing back any preserved unknown keys.
+func (c RigsConfig) MarshalJSON() ([]byte, error) {
+	type plain RigsConfig
+	return marshalWithExtra(plain(c), c.Extra)
+}
+
+// UnmarshalJSON decodes a RigEntry, keeping unknown keys in Extra.
+func (e *RigEntry) UnmarshalJSON(data []byte) error {
+	type plain RigEntry
+	var p plain
+	if err := json.Unmarshal(data, &p); err != nil {
+		return err
+	}
+	extra, err := unknownJSONFields(data, reflect.TypeOf(p))
+	if err != nil {
+		return err
+	}
+	p.Extra = extra
+	*e = RigEntry(p)
+	return nil
+}
+
+// MarshalJSON encodes a RigEntry, writing back any preserved unknown keys.
+func (e RigEntry) MarshalJSON() ([]byte, error) {
+	type plain RigEntry
+	return marshalWithExtra(plain(e), e.Extra)
+}
+
+// unknownJSONFields returns the keys of the JSON object data that don't map
+// to a json-tagged field of struct type t. Returns nil if there are none.
+func unknownJSONFields(data []byte, t reflect.Type) (map[string]json.RawMessage, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if !t.Field(i).IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = t.Field(i).Name
+		}
+		delete(fields, name)
+	}
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	return fields, nil
+}
+
+// marshalWithExtra encodes v (a struct without custom marshalers) and merges
+// in extra. Known fields always win over a preserved key of the same name.
+func marshalWithExtra(v interface{}, extra map[string]json.RawMessage) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || len(extra) == 0 {
+		return data, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	for key, raw := range extra {
+		if _, known := fields[key]; !known {
+			fields[key] = raw
+		}
+	}
+	return json.Marshal(fields)
+}
@@ -0,0 +1,92 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRigsConfigMigrationsCoverCurrentVersion(t *testing.T) {
+	if len(rigsConfigMigrations) != CurrentRigsVersion {
+		t.Errorf("%d migrations for CurrentRigsVersion %d", len(rigsConfigMigrations), CurrentRigsVersion)
+	}
+}
+
+func TestLoadRigsConfigMigratesUnversioned(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rigs.json")
+	if err := os.WriteFile(path, []byte(`{"rigs":{"gastown":{"git_url":"x"}}}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := LoadRigsConfig(path)
+	if err != nil {
+		t.Fatalf("LoadRigsConfig: %v", err)
+	}
+	if c.Version != CurrentRigsVersion {
+		t.Errorf("Version = %d, want %d", c.Version, CurrentRigsVersion)
+	}
+	if c.Rigs["gastown"].GitURL != "x" {
+		t.Errorf("rig lost in migration: %+v", c.Rigs)
+	}
+}
+
+func TestRigsConfigPreservesUnknownKeys(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rigs.json")
+	data := `{
+  "version": 1,
+  "groups": {"core": ["gastown"]},
+  "rigs": {
+    "gastown": {"git_url": "x", "added_at": "2026-01-01T00:00:00Z", "enabled": false}
+  }
+}`
+	if err := os.WriteFile(path, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := LoadRigsConfig(path)
+	if err != nil {
+		t.Fatalf("LoadRigsConfig: %v", err)
+	}
+	want := []string{"groups", "rigs.gastown.enabled"}
+	if got := c.UnknownFields(); strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("UnknownFields = %v, want %v", got, want)
+	}
+
+	// A rewrite that changes a known field keeps the unknown ones
+	entry := c.Rigs["gastown"]
+	entry.Priority = 5
+	c.Rigs["gastown"] = entry
+	if err := SaveRigsConfig(path, c); err != nil {
+		t.Fatalf("SaveRigsConfig: %v", err)
+	}
+	saved, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{`"groups"`, `"core"`, `"enabled": false`, `"priority": 5`} {
+		if !strings.Contains(string(saved), s) {
+			t.Errorf("rewritten file missing %s:\n%s", s, saved)
+		}
+	}
+
+	// Strict loading rejects them
+	_, err = LoadRigsConfigWithPolicy(path, UnknownKeysReject)
+	if !errors.Is(err, ErrUnknownField) || !strings.Contains(err.Error(), "rigs.gastown.enabled") {
+		t.Errorf("strict load error = %v, want ErrUnknownField naming the key", err)
+	}
+	if _, err := LoadRigsConfigWithPolicy(path, UnknownKeysPreserve); err != nil {
+		t.Errorf("lenient load: %v", err)
+	}
+}
+
+func TestLoadRigsConfigRejectsNewerVersion(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rigs.json")
+	if err := os.WriteFile(path, []byte(`{"version": 99, "rigs": {}}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadRigsConfig(path); !errors.Is(err, ErrInvalidVersion) {
+		t.Errorf("err = %v, want ErrInvalidVersion", err)
+	}
+}
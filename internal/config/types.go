@@ -2,6 +2,7 @@
 package config
 
 import (
+	"encoding/json"
 	"path/filepath"
 	"os"
 	"strings"
@@ -49,6 +50,12 @@ type TownSettings struct {
 	// Values override or extend the built-in presets.
 	// Example: {"gemini": {"command": "/custom/path/to/gemini"}}
 	Agents map[string]*RuntimeConfig `json:"agents,omitempty"`
+
+	// BeadIDScheme is a template for polecat agent bead IDs, for towns with
+	// an existing beads naming convention. Placeholders: {prefix} (the rig's
+	// beads prefix), {rig}, {name}. Example: "{prefix}-pc-{rig}-{name}".
+	// Default: "" ("<prefix>-<rig>-polecat-<name>")
+	BeadIDScheme string `json:"bead_id_scheme,omitempty"`
 }
 
 // NewTownSettings creates a new TownSettings with defaults.
@@ -139,14 +146,30 @@ const DefaultCrewName = "max"
 type RigsConfig struct {
 	Version int                 `json:"version"`
 	Rigs    map[string]RigEntry `json:"rigs"`
+
+	// CleanupDefaults maps 'gt cleanup' flag names to default values (e.g.
+	// {"jobs": "4"}). Explicit flags and GT_CLEANUP_* variables win. Shared
+	// defaults go in rigs.json; personal ones in rigs.local.json.
+	CleanupDefaults map[string]string `json:"cleanup_defaults,omitempty"`
+
+	// Extra holds keys this binary doesn't know (e.g. written by a newer gt).
+	// They are written back unchanged on save.
+	Extra map[string]json.RawMessage `json:"-"`
+
+	layer *rigsLayer // Set when loaded with a local override layer
 }
 
 // RigEntry represents a single rig in the registry.
 type RigEntry struct {
-	GitURL      string       `json:"git_url"`
-	LocalRepo   string       `json:"local_repo,omitempty"`
-	AddedAt     time.Time    `json:"added_at"`
-	BeadsConfig *BeadsConfig `json:"beads,omitempty"`
+	GitURL        string       `json:"git_url"`
+	LocalRepo     string       `json:"local_repo,omitempty"`
+	AddedAt       time.Time    `json:"added_at"`
+	BeadsConfig   *BeadsConfig `json:"beads,omitempty"`
+	DefaultBranch string       `json:"default_branch,omitempty"` // overrides auto-detection from origin/HEAD
+	Priority      int          `json:"priority,omitempty"`       // higher is processed/reported first; ties sort by name
+
+	// Extra holds keys this binary doesn't know; see RigsConfig.Extra.
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // BeadsConfig represents beads configuration for a rig.
@@ -160,6 +183,7 @@ type BeadsConfig struct {
 const CurrentTownVersion = 2
 
 // CurrentRigsVersion is the current schema version for RigsConfig.
+// Older files are upgraded on load; see rigsConfigMigrations.
 const CurrentRigsVersion = 1
 
 // CurrentRigConfigVersion is the current schema version for RigConfig.
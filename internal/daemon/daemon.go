@@ -736,7 +736,8 @@ func (d *Daemon) checkPolecatHealth(rigName, polecatName string) {
 	}
 
 	// Session is dead. Check if the polecat has work-on-hook.
-	agentBeadID := beads.PolecatBeadID(rigName, polecatName)
+	r := &rig.Rig{Name: rigName, Path: filepath.Join(d.config.TownRoot, rigName)}
+	agentBeadID := polecat.AgentBeadID(r, polecatName)
 	info, err := d.getAgentBeadInfo(agentBeadID)
 	if err != nil {
 		// Agent bead doesn't exist or error - polecat might not be registered
@@ -3,9 +3,9 @@ package doctor
 import (
 	"fmt"
 	"os"
-	"time"
 
 	"github.com/steveyegge/gastown/internal/boot"
+	"github.com/steveyegge/gastown/internal/style"
 )
 
 // BootHealthCheck verifies Boot watchdog health.
@@ -62,8 +62,7 @@ func (c *BootHealthCheck) Run(ctx *CheckContext) *CheckResult {
 	}
 
 	if !status.CompletedAt.IsZero() {
-		age := time.Since(status.CompletedAt).Round(time.Second)
-		details = append(details, fmt.Sprintf("Last run: %s ago", age))
+		details = append(details, fmt.Sprintf("Last run: %s", style.HumanizeTime(status.CompletedAt)))
 		if status.LastAction != "" {
 			details = append(details, fmt.Sprintf("Last action: %s", status.LastAction))
 		}
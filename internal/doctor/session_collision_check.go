@@ -0,0 +1,74 @@
+package doctor
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// SessionCollisionCheck detects rig agents that map to the same tmux session
+// name. Session names are gt-<rig>-<agent>, so rigs registered before hyphens
+// were disallowed can collide: rig "a" polecat "b-c" and rig "a-b" polecat
+// "c" both run as gt-a-b-c. Polecat session operations refuse colliding
+// names rather than act on the wrong rig's session.
+type SessionCollisionCheck struct {
+	BaseCheck
+}
+
+// NewSessionCollisionCheck creates a new session collision check.
+func NewSessionCollisionCheck() *SessionCollisionCheck {
+	return &SessionCollisionCheck{
+		BaseCheck: BaseCheck{
+			CheckName:        "session-collisions",
+			CheckDescription: "Detect agents in different rigs sharing a tmux session name",
+			CheckCategory:    CategoryInfrastructure,
+		},
+	}
+}
+
+// Run checks every rig's agents for session name collisions.
+func (c *SessionCollisionCheck) Run(ctx *CheckContext) *CheckResult {
+	rigsConfig, err := config.LoadRigsConfig(filepath.Join(ctx.TownRoot, "mayor", "rigs.json"))
+	if err != nil {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: "No rigs configured",
+		}
+	}
+
+	agents := make(map[string][]string, len(rigsConfig.Rigs))
+	for name := range rigsConfig.Rigs {
+		agents[name] = polecat.RigSessionAgents(filepath.Join(ctx.TownRoot, name))
+	}
+	running, _ := tmux.NewTmux().ListSessions() // Collisions are reported even without tmux
+
+	collisions := polecat.FindSessionCollisions(agents, running)
+	if len(collisions) == 0 {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: fmt.Sprintf("No session name collisions across %s", style.Count(len(agents), "rig", "rigs")),
+		}
+	}
+
+	details := make([]string, len(collisions))
+	for i, col := range collisions {
+		details[i] = fmt.Sprintf("%s: %s", col.Session, strings.Join(col.Agents, ", "))
+		if col.Running {
+			details[i] += " (running)"
+		}
+	}
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusWarning,
+		Message: fmt.Sprintf("Found %s across rigs", style.Count(len(collisions), "session name collision", "session name collisions")),
+		Details: details,
+		FixHint: "Nuke and re-create the colliding polecats under other names, or rename one rig so neither name extends the other with a hyphen",
+	}
+}
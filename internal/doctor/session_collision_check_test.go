@@ -0,0 +1,47 @@
+package doctor
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSessionCollisionCheck(t *testing.T) {
+	townRoot := t.TempDir()
+	writeRigs := func(names ...string) {
+		t.Helper()
+		var entries []string
+		for _, name := range names {
+			entries = append(entries, `"`+name+`": {"git_url": "https://example.com/`+name+`.git"}`)
+		}
+		data := `{"version": 1, "rigs": {` + strings.Join(entries, ", ") + `}}`
+		if err := os.MkdirAll(filepath.Join(townRoot, "mayor"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(townRoot, "mayor", "rigs.json"), []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, dir := range []string{"a/polecats/b-c", "a/polecats/Toast", "a-b/polecats/c"} {
+		if err := os.MkdirAll(filepath.Join(townRoot, dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	check := NewSessionCollisionCheck()
+	ctx := &CheckContext{TownRoot: townRoot}
+
+	writeRigs("a")
+	if result := check.Run(ctx); result.Status != StatusOK {
+		t.Fatalf("single rig: status = %v, want OK (%s)", result.Status, result.Message)
+	}
+
+	writeRigs("a", "a-b")
+	result := check.Run(ctx)
+	if result.Status != StatusWarning {
+		t.Fatalf("status = %v, want warning (%s)", result.Status, result.Message)
+	}
+	if len(result.Details) != 1 || !strings.HasPrefix(result.Details[0], "gt-a-b-c: a-b/c, a/b-c") {
+		t.Errorf("details = %v, want the gt-a-b-c collision", result.Details)
+	}
+}
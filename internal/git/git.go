@@ -3,11 +3,14 @@ package git
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"time"
 )
 
 // GitError contains raw output from a git command for agent observation.
@@ -36,7 +39,8 @@ func (e *GitError) Unwrap() error {
 // Git wraps git operations for a working directory.
 type Git struct {
 	workDir string
-	gitDir  string // Optional: explicit git directory (for bare repos)
+	gitDir  string          // Optional: explicit git directory (for bare repos)
+	ctx     context.Context // Optional: kills running commands when done (see WithContext)
 }
 
 // NewGit creates a new Git wrapper for the given directory.
@@ -56,6 +60,17 @@ func (g *Git) WorkDir() string {
 	return g.workDir
 }
 
+// gitWaitDelay bounds how long a command killed by its context may keep its
+// output pipes open before Wait gives up on them.
+const gitWaitDelay = 5 * time.Second
+
+// WithContext returns a copy of g whose commands are killed once ctx is done.
+func (g *Git) WithContext(ctx context.Context) *Git {
+	c := *g
+	c.ctx = ctx
+	return &c
+}
+
 // IsRepo returns true if the workDir is a git repository.
 func (g *Git) IsRepo() bool {
 	_, err := g.run("rev-parse", "--git-dir")
@@ -64,12 +79,30 @@ func (g *Git) IsRepo() bool {
 
 // run executes a git command and returns stdout.
 func (g *Git) run(args ...string) (string, error) {
+	out, err := g.runRaw(args...)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(out), nil
+}
+
+// runRaw is run without trimming stdout, for output whose whitespace is
+// significant (patches). Stdout is returned even when the command fails.
+func (g *Git) runRaw(args ...string) (string, error) {
 	// If gitDir is set (bare repo), prepend --git-dir flag
 	if g.gitDir != "" {
 		args = append([]string{"--git-dir=" + g.gitDir}, args...)
 	}
 
-	cmd := exec.Command("git", args...)
+	var cmd *exec.Cmd
+	if g.ctx != nil {
+		cmd = exec.CommandContext(g.ctx, "git", args...)
+		// A killed git can leave children holding its pipes open (hooks,
+		// fsmonitor); don't let them block Wait indefinitely
+		cmd.WaitDelay = gitWaitDelay
+	} else {
+		cmd = exec.Command("git", args...)
+	}
 	if g.workDir != "" {
 		cmd.Dir = g.workDir
 	}
@@ -80,10 +113,10 @@ func (g *Git) run(args ...string) (string, error) {
 
 	err := cmd.Run()
 	if err != nil {
-		return "", g.wrapError(err, stdout.String(), stderr.String(), args)
+		return stdout.String(), g.wrapError(err, stdout.String(), stderr.String(), args)
 	}
 
-	return strings.TrimSpace(stdout.String()), nil
+	return stdout.String(), nil
 }
 
 // wrapError wraps git errors with context.
@@ -364,6 +397,35 @@ func (g *Git) HasUncommittedChanges() (bool, error) {
 	return !status.Clean, nil
 }
 
+// UncommittedPatch returns a binary-safe patch of all uncommitted changes
+// (staged, unstaged, and untracked files) relative to HEAD. Untracked files
+// are diffed against /dev/null, so the index is left as it was. Returns ""
+// if clean.
+func (g *Git) UncommittedPatch() (string, error) {
+	patch, err := g.runRaw("diff", "HEAD", "--binary")
+	if err != nil {
+		return "", err
+	}
+
+	untracked, err := g.runRaw("ls-files", "--others", "--exclude-standard", "-z")
+	if err != nil {
+		return "", err
+	}
+	for _, file := range strings.Split(untracked, "\x00") {
+		if file == "" {
+			continue
+		}
+		// diff --no-index exits 1 when the files differ, as they always do here
+		out, err := g.runRaw("diff", "--no-index", "--binary", "--", os.DevNull, file)
+		var exitErr *exec.ExitError
+		if err != nil && !(errors.As(err, &exitErr) && exitErr.ExitCode() == 1) {
+			return "", err
+		}
+		patch += out
+	}
+	return patch, nil
+}
+
 // RemoteURL returns the URL for the given remote.
 func (g *Git) RemoteURL(remote string) (string, error) {
 	return g.run("remote", "get-url", remote)
@@ -578,11 +640,16 @@ func (g *Git) Rev(ref string) (string, error) {
 }
 
 // IsAncestor checks if ancestor is an ancestor of descendant.
+// Wraps `git merge-base --is-ancestor`, so the answer depends only on the two
+// refs given, not on what is currently checked out. Use it to ask whether a
+// branch tip (ancestor) is already contained in a base branch (descendant).
 func (g *Git) IsAncestor(ancestor, descendant string) (bool, error) {
 	_, err := g.run("merge-base", "--is-ancestor", ancestor, descendant)
 	if err != nil {
-		// Exit code 1 means not an ancestor, not an error
-		if strings.Contains(err.Error(), "exit status 1") {
+		// Exit code 1 means not an ancestor, not an error.
+		// Other codes (e.g. 128 for an unknown ref) are real failures.
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
 			return false, nil
 		}
 		return false, err
@@ -785,11 +852,18 @@ func (g *Git) WorktreePrune() error {
 	return err
 }
 
+// GC runs git gc to repack the repository and prune unreachable objects.
+func (g *Git) GC() error {
+	_, err := g.run("gc", "--quiet")
+	return err
+}
+
 // Worktree represents a git worktree.
 type Worktree struct {
-	Path   string
-	Branch string
-	Commit string
+	Path     string
+	Branch   string
+	Commit   string
+	Prunable bool // Worktree directory is gone; 'git worktree prune' would remove the entry
 }
 
 // WorktreeList returns all worktrees for this repository.
@@ -818,6 +892,8 @@ func (g *Git) WorktreeList() ([]Worktree, error) {
 			current.Commit = strings.TrimPrefix(line, "HEAD ")
 		case strings.HasPrefix(line, "branch "):
 			current.Branch = strings.TrimPrefix(line, "branch refs/heads/")
+		case line == "prunable" || strings.HasPrefix(line, "prunable "):
+			current.Prunable = true
 		}
 	}
 
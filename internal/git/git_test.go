@@ -4,6 +4,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -189,6 +190,120 @@ func TestHasUncommittedChanges(t *testing.T) {
 	}
 }
 
+func TestUncommittedPatch(t *testing.T) {
+	dir := initTestRepo(t)
+	g := NewGit(dir)
+
+	patch, err := g.UncommittedPatch()
+	if err != nil {
+		t.Fatalf("UncommittedPatch: %v", err)
+	}
+	if patch != "" {
+		t.Errorf("expected empty patch for clean repo, got %q", patch)
+	}
+
+	// Modify a tracked file and add an untracked one
+	if err := os.WriteFile(filepath.Join(dir, "README.md"), []byte("# Changed\n"), 0644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "new.txt"), []byte("stray\n"), 0644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	patch, err = g.UncommittedPatch()
+	if err != nil {
+		t.Fatalf("UncommittedPatch: %v", err)
+	}
+	if !strings.Contains(patch, "README.md") || !strings.Contains(patch, "new.txt") {
+		t.Errorf("patch missing changes:\n%s", patch)
+	}
+
+	// The patch must apply cleanly to a fresh checkout
+	patchFile := filepath.Join(t.TempDir(), "salvage.patch")
+	if err := os.WriteFile(patchFile, []byte(patch), 0644); err != nil {
+		t.Fatalf("write patch: %v", err)
+	}
+	cmd := exec.Command("git", "checkout", "--", ".")
+	cmd.Dir = dir
+	_ = cmd.Run()
+	cmd = exec.Command("git", "reset", "-q")
+	cmd.Dir = dir
+	_ = cmd.Run()
+	_ = os.Remove(filepath.Join(dir, "new.txt"))
+	cmd = exec.Command("git", "apply", patchFile)
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("git apply: %v\n%s", err, out)
+	}
+	if data, _ := os.ReadFile(filepath.Join(dir, "new.txt")); string(data) != "stray\n" {
+		t.Errorf("new.txt after apply = %q", data)
+	}
+}
+
+func TestUncommittedPatchTrailingBlankContext(t *testing.T) {
+	dir := initTestRepo(t)
+	g := NewGit(dir)
+	gitCmd := func(args ...string) string {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+		return string(out)
+	}
+
+	// The change's last hunk ends in a blank context line, which trimming
+	// the diff output would cut off
+	notes := filepath.Join(dir, "notes.txt")
+	if err := os.WriteFile(notes, []byte("one\ntwo\nthree\n\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	gitCmd("add", "notes.txt")
+	gitCmd("commit", "-q", "-m", "notes")
+	if err := os.WriteFile(notes, []byte("one\ntwo\nTHREE\n\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "new.txt"), []byte("stray\n\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	patch, err := g.UncommittedPatch()
+	if err != nil {
+		t.Fatalf("UncommittedPatch: %v", err)
+	}
+	if !strings.Contains(patch, "+THREE\n \n") {
+		t.Errorf("patch lost its trailing blank context line:\n%q", patch)
+	}
+
+	// Reading the work must not stage anything
+	if staged := gitCmd("diff", "--cached", "--name-only"); staged != "" {
+		t.Errorf("UncommittedPatch staged %q", staged)
+	}
+	if status := gitCmd("status", "--porcelain", "new.txt"); !strings.HasPrefix(status, "??") {
+		t.Errorf("new.txt status = %q, want still untracked", status)
+	}
+
+	// The patch applies to a clean checkout
+	patchFile := filepath.Join(t.TempDir(), "salvage.patch")
+	if err := os.WriteFile(patchFile, []byte(patch), 0644); err != nil {
+		t.Fatal(err)
+	}
+	gitCmd("checkout", "--", ".")
+	if err := os.Remove(filepath.Join(dir, "new.txt")); err != nil {
+		t.Fatal(err)
+	}
+	gitCmd("apply", "--check", patchFile)
+	gitCmd("apply", patchFile)
+	if data, _ := os.ReadFile(notes); string(data) != "one\ntwo\nTHREE\n\n" {
+		t.Errorf("notes.txt after apply = %q", data)
+	}
+	if data, _ := os.ReadFile(filepath.Join(dir, "new.txt")); string(data) != "stray\n\n" {
+		t.Errorf("new.txt after apply = %q", data)
+	}
+}
+
 func TestCheckout(t *testing.T) {
 	dir := initTestRepo(t)
 	g := NewGit(dir)
@@ -242,6 +357,59 @@ func TestRev(t *testing.T) {
 	}
 }
 
+func TestIsAncestor(t *testing.T) {
+	dir := initTestRepo(t)
+	g := NewGit(dir)
+
+	base, err := g.CurrentBranch()
+	if err != nil {
+		t.Fatalf("CurrentBranch: %v", err)
+	}
+
+	// Create a feature branch with one extra commit, then return to base
+	if err := g.CreateBranch("feature"); err != nil {
+		t.Fatalf("CreateBranch: %v", err)
+	}
+	if err := g.Checkout("feature"); err != nil {
+		t.Fatalf("Checkout feature: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "feature.txt"), []byte("feature\n"), 0644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+	if err := g.Add("feature.txt"); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if err := g.Commit("feature work"); err != nil {
+		t.Fatalf("Commit: %v", err)
+	}
+	if err := g.Checkout(base); err != nil {
+		t.Fatalf("Checkout base: %v", err)
+	}
+
+	// Base is contained in feature
+	ok, err := g.IsAncestor(base, "feature")
+	if err != nil {
+		t.Fatalf("IsAncestor(base, feature): %v", err)
+	}
+	if !ok {
+		t.Error("expected base to be an ancestor of feature")
+	}
+
+	// Feature is not yet contained in base, regardless of what is checked out
+	ok, err = g.IsAncestor("feature", base)
+	if err != nil {
+		t.Fatalf("IsAncestor(feature, base): %v", err)
+	}
+	if ok {
+		t.Error("expected feature not to be an ancestor of base")
+	}
+
+	// Unknown refs are errors, not "not an ancestor"
+	if _, err := g.IsAncestor("does-not-exist", base); err == nil {
+		t.Error("expected error for unknown ref")
+	}
+}
+
 func TestFetchBranch(t *testing.T) {
 	// Create a "remote" repo
 	remoteDir := t.TempDir()
@@ -395,3 +563,38 @@ func TestCheckConflicts_WithConflict(t *testing.T) {
 		t.Error("expected clean working directory after CheckConflicts")
 	}
 }
+
+func TestWorktreeListPrunable(t *testing.T) {
+	dir := initTestRepo(t)
+	g := NewGit(dir)
+
+	wtPath := filepath.Join(t.TempDir(), "gone")
+	if err := g.WorktreeAdd(wtPath, "gone"); err != nil {
+		t.Fatalf("WorktreeAdd: %v", err)
+	}
+	if err := os.RemoveAll(wtPath); err != nil {
+		t.Fatalf("remove worktree dir: %v", err)
+	}
+
+	worktrees, err := g.WorktreeList()
+	if err != nil {
+		t.Fatalf("WorktreeList: %v", err)
+	}
+	var prunable []string
+	for _, wt := range worktrees {
+		if wt.Prunable {
+			prunable = append(prunable, wt.Path)
+		}
+	}
+	if len(prunable) != 1 || !strings.HasSuffix(prunable[0], "gone") {
+		t.Fatalf("prunable = %v, want the deleted worktree", prunable)
+	}
+
+	if err := g.WorktreePrune(); err != nil {
+		t.Fatalf("WorktreePrune: %v", err)
+	}
+	worktrees, _ = g.WorktreeList()
+	if len(worktrees) != 1 {
+		t.Errorf("worktrees after prune = %d, want 1", len(worktrees))
+	}
+}
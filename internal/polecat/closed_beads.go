@@ -0,0 +1,64 @@
+package polecat
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/steveyegge/gastown/internal/git"
+)
+
+// ClosedBeadPolecat is a polecat whose agent bead is closed while the polecat
+// is still present and not done. Nothing will drive it to done any more (the
+// bead was typically closed by hand with bd), so it is effectively abandoned;
+// the state-only check in 'gt cleanup' never reaps it.
+type ClosedBeadPolecat struct {
+	Name               string `json:"name"`
+	State              State  `json:"state"`
+	BeadID             string `json:"bead_id"`
+	BeadStatus         string `json:"bead_status"`
+	HasUncommittedWork bool   `json:"has_uncommitted_work"`
+}
+
+// FindClosedBeadPolecats returns the rig's polecats that are not done but
+// whose agent bead is closed (or tombstoned), sorted by name. Polecats whose
+// bead can't be read are skipped: a missing bead is fsck's concern, not
+// evidence of abandonment.
+func (m *Manager) FindClosedBeadPolecats() ([]ClosedBeadPolecat, error) {
+	polecats, err := m.List()
+	if err != nil {
+		return nil, fmt.Errorf("listing polecats: %w", err)
+	}
+
+	found := findClosedBeadPolecats(polecats, m.agentBeadID, func(id string) string {
+		issue, _, err := m.beads.GetAgentBead(id)
+		if err != nil || issue == nil {
+			return ""
+		}
+		return issue.Status
+	})
+	for i := range found {
+		status, err := git.NewGit(m.clonePath(found[i].Name)).CheckUncommittedWork()
+		// Unknown counts as uncommitted: reaping must not guess
+		found[i].HasUncommittedWork = err != nil || !status.Clean()
+	}
+	return found, nil
+}
+
+// findClosedBeadPolecats selects the not-done polecats whose agent bead
+// (named by beadID) has a closed status according to beadStatus.
+func findClosedBeadPolecats(polecats []*Polecat, beadID func(name string) string, beadStatus func(id string) string) []ClosedBeadPolecat {
+	var found []ClosedBeadPolecat
+	for _, p := range polecats {
+		if p.State == StateDone {
+			continue
+		}
+		id := beadID(p.Name)
+		status := beadStatus(id)
+		if status != "closed" && status != "tombstone" {
+			continue
+		}
+		found = append(found, ClosedBeadPolecat{Name: p.Name, State: p.State, BeadID: id, BeadStatus: status})
+	}
+	sort.Slice(found, func(i, j int) bool { return found[i].Name < found[j].Name })
+	return found
+}
@@ -0,0 +1,34 @@
+package polecat
+
+import "testing"
+
+func TestFindClosedBeadPolecats(t *testing.T) {
+	polecats := []*Polecat{
+		{Name: "Toast", State: StateWorking},
+		{Name: "Cheedo", State: StateDone},
+		{Name: "Nux", State: StateStuck},
+		{Name: "Ace", State: StateWorking},
+		{Name: "Furiosa", State: StateWorking},
+	}
+	statuses := map[string]string{
+		"bead-Toast":  "closed",
+		"bead-Cheedo": "closed", // Done: cleanup reaps it anyway
+		"bead-Nux":    "tombstone",
+		"bead-Ace":    "open",
+		// Furiosa's bead is missing
+	}
+
+	got := findClosedBeadPolecats(polecats,
+		func(name string) string { return "bead-" + name },
+		func(id string) string { return statuses[id] })
+
+	if len(got) != 2 {
+		t.Fatalf("found = %+v, want Nux and Toast", got)
+	}
+	if got[0].Name != "Nux" || got[0].BeadStatus != "tombstone" || got[0].State != StateStuck {
+		t.Errorf("found[0] = %+v, want stuck Nux with tombstoned bead", got[0])
+	}
+	if got[1].Name != "Toast" || got[1].BeadID != "bead-Toast" || got[1].BeadStatus != "closed" {
+		t.Errorf("found[1] = %+v, want Toast with closed bead-Toast", got[1])
+	}
+}
@@ -0,0 +1,80 @@
+package polecat
+
+import (
+	"fmt"
+	"time"
+)
+
+// Details is everything the manager knows about one polecat, gathered for
+// inspection before it is nuked ('gt polecat show'). Session details live
+// with the SessionManager and are not included.
+type Details struct {
+	Polecat
+
+	// Agent bead
+	BeadID      string    `json:"bead_id"`
+	BeadStatus  string    `json:"bead_status,omitempty"` // Empty when the bead is missing or unreadable
+	BeadLabels  []string  `json:"bead_labels,omitempty"`
+	HookBead    string    `json:"hook_bead,omitempty"` // Work pinned to the polecat's hook
+	BeadError   string    `json:"bead_error,omitempty"`
+	BeadCreated time.Time `json:"bead_created_at,omitempty"`
+
+	// StateChangedAt approximates when the polecat entered its current state
+	// (when it finished, for a done polecat). Zero if unknown.
+	StateChangedAt time.Time `json:"state_changed_at,omitempty"`
+
+	// Branch merge status against the rig's default branch. BranchMerged is
+	// nil when it couldn't be determined.
+	BaseRef      string `json:"base_ref,omitempty"`
+	BranchMerged *bool  `json:"branch_merged,omitempty"`
+
+	// WorktreeLocks are git lock files present in the worktree.
+	WorktreeLocks []string `json:"worktree_locks,omitempty"`
+}
+
+// Inspect gathers the details for polecat name. Only a missing polecat is an
+// error; anything else that can't be read is left empty.
+func (m *Manager) Inspect(name string) (*Details, error) {
+	p, err := m.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	d := &Details{Polecat: *p, BeadID: m.agentBeadID(name)}
+
+	issue, fields, err := m.beads.GetAgentBead(d.BeadID)
+	switch {
+	case err != nil:
+		d.BeadError = err.Error()
+	case issue != nil:
+		d.BeadStatus = issue.Status
+		d.BeadLabels = issue.Labels
+		if t, err := time.Parse(time.RFC3339, issue.CreatedAt); err == nil {
+			d.BeadCreated = t
+		}
+		if fields != nil {
+			d.HookBead = fields.HookBead
+		}
+	}
+	d.StateChangedAt = m.StateChangedAt(name)
+
+	if repoGit, err := m.repoBase(); err == nil && p.Branch != "" {
+		d.BaseRef = m.defaultBranchRef(repoGit)
+		if merged, err := repoGit.IsAncestor(p.Branch, d.BaseRef); err == nil {
+			d.BranchMerged = &merged
+		}
+	}
+	d.WorktreeLocks = m.WorktreeLocks(name)
+	return d, nil
+}
+
+// MergeStatus renders BranchMerged for display.
+func (d *Details) MergeStatus() string {
+	switch {
+	case d.BranchMerged == nil:
+		return "unknown"
+	case *d.BranchMerged:
+		return fmt.Sprintf("merged into %s", d.BaseRef)
+	default:
+		return fmt.Sprintf("not merged into %s", d.BaseRef)
+	}
+}
@@ -0,0 +1,200 @@
+package polecat
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/git"
+)
+
+// FsckEntry is one polecat's row in the consistency matrix.
+type FsckEntry struct {
+	Name           string   `json:"name"`
+	State          State    `json:"state"`
+	Worktree       bool     `json:"worktree"`        // Clone path is a git worktree
+	Branch         string   `json:"branch"`          // Branch checked out in the worktree
+	BranchExists   bool     `json:"branch_exists"`   // Branch exists in the repo base
+	SessionRunning bool     `json:"session_running"` // Tmux session is up
+	BeadID         string   `json:"bead_id"`
+	BeadStatus     string   `json:"bead_status"` // Empty when the agent bead is missing
+	Problems       []string `json:"problems,omitempty"`
+}
+
+// OK returns true if no inconsistencies were found for this polecat.
+func (e *FsckEntry) OK() bool {
+	return len(e.Problems) == 0
+}
+
+// FsckReport is the result of a consistency check over a rig's polecats.
+type FsckReport struct {
+	Rig      string      `json:"rig"`
+	Polecats []FsckEntry `json:"polecats"`
+
+	// OrphanSessions are running polecat sessions with no polecat directory.
+	OrphanSessions []string `json:"orphan_sessions,omitempty"`
+
+	// OrphanBeads are open polecat agent beads with no polecat directory.
+	OrphanBeads []string `json:"orphan_beads,omitempty"`
+}
+
+// ProblemCount returns the total number of inconsistencies in the report.
+func (r *FsckReport) ProblemCount() int {
+	n := len(r.OrphanSessions) + len(r.OrphanBeads)
+	for _, e := range r.Polecats {
+		n += len(e.Problems)
+	}
+	return n
+}
+
+// Fsck checks every polecat in the rig for consistency between its worktree,
+// branch, tmux session, and agent bead, and looks for sessions and agent beads
+// left behind by polecats that no longer exist.
+//
+// Checks performed per polecat:
+//   - worktree exists (a directory without one is half-removed)
+//   - branch exists in the repo base
+//   - session state matches polecat state (done with a live session is a zombie,
+//     working with no session is stalled)
+//   - agent bead exists and is not closed while the polecat is still present
+func (m *Manager) Fsck(sessMgr *SessionManager) (*FsckReport, error) {
+	report := &FsckReport{Rig: m.rig.Name}
+
+	polecatsDir := filepath.Join(m.rig.Path, "polecats")
+	entries, err := os.ReadDir(polecatsDir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("reading polecats dir: %w", err)
+	}
+
+	present := make(map[string]bool)
+	for _, entry := range entries {
+		if !entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		present[entry.Name()] = true
+	}
+
+	repoGit, repoErr := m.repoBase()
+
+	names := make([]string, 0, len(present))
+	for name := range present {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		report.Polecats = append(report.Polecats, m.fsckPolecat(name, repoGit, repoErr, sessMgr))
+	}
+
+	// Sessions without a polecat directory
+	if sessMgr != nil {
+		if infos, err := sessMgr.List(); err == nil {
+			for _, info := range infos {
+				if isRigSingletonSession(info.Polecat) || present[info.Polecat] {
+					continue
+				}
+				report.OrphanSessions = append(report.OrphanSessions, info.SessionID)
+			}
+		}
+	}
+
+	// Open agent beads without a polecat directory
+	scheme, err := IDSchemeForRig(m.rig)
+	if err != nil {
+		return nil, err
+	}
+	if issues, err := m.beads.List(beads.ListOptions{Type: "agent", Status: "open", Priority: -1}); err == nil {
+		for _, issue := range issues {
+			rigName, name, ok := scheme.ParsePolecatID(issue.ID)
+			if !ok || rigName != m.rig.Name {
+				continue
+			}
+			if !present[name] {
+				report.OrphanBeads = append(report.OrphanBeads, issue.ID)
+			}
+		}
+	}
+
+	sort.Strings(report.OrphanSessions)
+	sort.Strings(report.OrphanBeads)
+
+	return report, nil
+}
+
+// fsckPolecat runs the per-polecat consistency checks.
+func (m *Manager) fsckPolecat(name string, repoGit *git.Git, repoErr error, sessMgr *SessionManager) FsckEntry {
+	entry := FsckEntry{Name: name, BeadID: m.agentBeadID(name)}
+
+	if p, err := m.Get(name); err == nil {
+		entry.State = p.State
+	}
+
+	// Worktree
+	clonePath := m.clonePath(name)
+	if _, err := os.Stat(filepath.Join(clonePath, ".git")); err == nil {
+		entry.Worktree = true
+	} else {
+		entry.Problems = append(entry.Problems, "worktree missing (half-removed?)")
+	}
+
+	// Branch
+	if entry.Worktree {
+		if branch, err := git.NewGit(clonePath).CurrentBranch(); err == nil {
+			entry.Branch = branch
+		}
+	}
+	switch {
+	case entry.Branch == "":
+		if entry.Worktree {
+			entry.Problems = append(entry.Problems, "cannot determine branch")
+		}
+	case repoErr != nil:
+		entry.Problems = append(entry.Problems, fmt.Sprintf("cannot check branch: %v", repoErr))
+	default:
+		exists, err := repoGit.BranchExists(entry.Branch)
+		entry.BranchExists = err == nil && exists
+		if !entry.BranchExists {
+			entry.Problems = append(entry.Problems, fmt.Sprintf("branch %s missing", entry.Branch))
+		}
+	}
+
+	// Session vs state
+	if sessMgr != nil {
+		running, err := sessMgr.IsRunning(name)
+		entry.SessionRunning = running
+		switch {
+		case errors.Is(err, ErrSessionAmbiguous):
+			entry.Problems = append(entry.Problems, "session name collides with another rig (see gt doctor)")
+		case entry.State == StateDone && entry.SessionRunning:
+			entry.Problems = append(entry.Problems, "done but session still running (zombie)")
+		case entry.State.IsActive() && !entry.SessionRunning:
+			entry.Problems = append(entry.Problems, "working but no session")
+		}
+	}
+
+	// Agent bead
+	issue, _, err := m.beads.GetAgentBead(entry.BeadID)
+	switch {
+	case err != nil:
+		entry.Problems = append(entry.Problems, fmt.Sprintf("cannot read agent bead: %v", err))
+	case issue == nil:
+		entry.Problems = append(entry.Problems, "agent bead missing")
+	default:
+		entry.BeadStatus = issue.Status
+		if issue.Status == "closed" || issue.Status == "tombstone" {
+			entry.Problems = append(entry.Problems, fmt.Sprintf("agent bead %s but polecat still present", issue.Status))
+		}
+	}
+
+	return entry
+}
+
+// isRigSingletonSession reports whether a session suffix under gt-<rig>- belongs
+// to a rig-level agent rather than a polecat.
+func isRigSingletonSession(suffix string) bool {
+	return suffix == "witness" || suffix == "refinery" || strings.HasPrefix(suffix, "crew-")
+}
@@ -0,0 +1,87 @@
+package polecat
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+func TestFsckHalfRemovedPolecat(t *testing.T) {
+	root := t.TempDir()
+	// Polecat directory exists but has no worktree inside it
+	if err := os.MkdirAll(filepath.Join(root, "polecats", "Toast"), 0755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Join(root, "mayor", "rig"), 0755); err != nil {
+		t.Fatalf("mkdir mayor/rig: %v", err)
+	}
+
+	r := &rig.Rig{Name: "test-rig", Path: root}
+	m := NewManager(r, git.NewGit(root))
+
+	report, err := m.Fsck(nil)
+	if err != nil {
+		t.Fatalf("Fsck: %v", err)
+	}
+	if len(report.Polecats) != 1 {
+		t.Fatalf("polecats = %d, want 1", len(report.Polecats))
+	}
+
+	entry := report.Polecats[0]
+	if entry.Worktree {
+		t.Error("Worktree = true, want false")
+	}
+	if entry.OK() {
+		t.Fatal("expected problems for half-removed polecat")
+	}
+	if !strings.Contains(strings.Join(entry.Problems, ";"), "worktree missing") {
+		t.Errorf("problems = %v, want worktree missing", entry.Problems)
+	}
+	if report.ProblemCount() < 1 {
+		t.Errorf("ProblemCount = %d, want >= 1", report.ProblemCount())
+	}
+}
+
+func TestFsckReportsCollidingSession(t *testing.T) {
+	townRoot := t.TempDir()
+	for _, dir := range []string{"a/polecats/b-c", "a/mayor/rig", "a-b/polecats/c"} {
+		if err := os.MkdirAll(filepath.Join(townRoot, dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	r := &rig.Rig{Name: "a", Path: filepath.Join(townRoot, "a")}
+	m := NewManager(r, git.NewGit(r.Path))
+	sessMgr := NewSessionManager(tmux.NewTmux(), r)
+	sessMgr.siblings = map[string]string{"a-b": filepath.Join(townRoot, "a-b")}
+
+	report, err := m.Fsck(sessMgr)
+	if err != nil {
+		t.Fatalf("Fsck: %v", err)
+	}
+	if len(report.Polecats) != 1 {
+		t.Fatalf("polecats = %d, want 1", len(report.Polecats))
+	}
+	problems := strings.Join(report.Polecats[0].Problems, ";")
+	if !strings.Contains(problems, "session name collides with another rig") {
+		t.Errorf("problems = %s, want a session collision", problems)
+	}
+	if strings.Contains(problems, "no session") {
+		t.Errorf("problems = %s, want the collision instead of a missing session", problems)
+	}
+}
+
+func TestIsRigSingletonSession(t *testing.T) {
+	for _, s := range []string{"witness", "refinery", "crew-max"} {
+		if !isRigSingletonSession(s) {
+			t.Errorf("isRigSingletonSession(%q) = false, want true", s)
+		}
+	}
+	if isRigSingletonSession("Toast") {
+		t.Error("isRigSingletonSession(Toast) = true, want false")
+	}
+}
@@ -0,0 +1,44 @@
+package polecat
+
+import "fmt"
+
+// PruneWorktrees removes worktree entries in the rig's repo base whose
+// directories no longer exist (e.g. a polecat deleted by hand) and returns
+// their paths. With dryRun, the entries are only reported.
+func (m *Manager) PruneWorktrees(dryRun bool) ([]string, error) {
+	repoGit, err := m.repoBase()
+	if err != nil {
+		return nil, err
+	}
+
+	worktrees, err := repoGit.WorktreeList()
+	if err != nil {
+		return nil, fmt.Errorf("listing worktrees: %w", err)
+	}
+
+	var prunable []string
+	for _, wt := range worktrees {
+		if wt.Prunable {
+			prunable = append(prunable, wt.Path)
+		}
+	}
+	if dryRun || len(prunable) == 0 {
+		return prunable, nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := repoGit.WorktreePrune(); err != nil {
+		return nil, fmt.Errorf("pruning worktrees: %w", err)
+	}
+	return prunable, nil
+}
+
+// GCRepo runs git gc on the rig's repo base.
+func (m *Manager) GCRepo() error {
+	repoGit, err := m.repoBase()
+	if err != nil {
+		return err
+	}
+	return repoGit.GC()
+}
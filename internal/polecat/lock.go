@@ -0,0 +1,123 @@
+package polecat
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// LockPolicy controls how RemoveAll treats a polecat whose worktree is locked
+// by git (an index.lock, HEAD.lock, or a 'git worktree lock').
+type LockPolicy string
+
+const (
+	LockSkip  LockPolicy = "skip"  // Leave the polecat in place (default)
+	LockWait  LockPolicy = "wait"  // Retry until the locks clear or LockTimeout passes
+	LockForce LockPolicy = "force" // Delete the lock files and remove anyway (dangerous)
+)
+
+// DefaultLockTimeout is how long LockWait waits when RemoveOptions.LockTimeout is unset.
+const DefaultLockTimeout = 30 * time.Second
+
+// lockPollInterval is how often LockWait re-checks a locked worktree.
+var lockPollInterval = 500 * time.Millisecond
+
+// ErrWorktreeLocked is returned when a polecat's worktree is locked by git
+// and the lock policy does not allow removing it.
+var ErrWorktreeLocked = errors.New("worktree locked by git")
+
+// ParseLockPolicy parses a --on-locked value. The empty string means LockSkip.
+func ParseLockPolicy(s string) (LockPolicy, error) {
+	switch p := LockPolicy(s); p {
+	case "":
+		return LockSkip, nil
+	case LockSkip, LockWait, LockForce:
+		return p, nil
+	default:
+		return "", fmt.Errorf("invalid lock policy %q (want skip, wait, or force)", s)
+	}
+}
+
+// WorktreeLocks returns the git lock files currently present for the
+// polecat's worktree. An empty result means the worktree is not locked.
+func (m *Manager) WorktreeLocks(name string) []string {
+	gitDir := worktreeGitDir(m.clonePath(name))
+	if gitDir == "" {
+		return nil
+	}
+
+	var locks []string
+	for _, f := range []string{"index.lock", "HEAD.lock", "locked"} {
+		path := filepath.Join(gitDir, f)
+		if _, err := os.Stat(path); err == nil {
+			locks = append(locks, path)
+		}
+	}
+	return locks
+}
+
+// worktreeGitDir returns the git directory for a clone or worktree: the .git
+// directory itself, or the directory a worktree's .git file points to.
+func worktreeGitDir(clonePath string) string {
+	dotGit := filepath.Join(clonePath, ".git")
+	info, err := os.Stat(dotGit)
+	if err != nil {
+		return ""
+	}
+	if info.IsDir() {
+		return dotGit
+	}
+
+	data, err := os.ReadFile(dotGit)
+	if err != nil {
+		return ""
+	}
+	gitDir, ok := strings.CutPrefix(strings.TrimSpace(string(data)), "gitdir:")
+	if !ok {
+		return ""
+	}
+	gitDir = strings.TrimSpace(gitDir)
+	if !filepath.IsAbs(gitDir) {
+		gitDir = filepath.Join(clonePath, gitDir)
+	}
+	return gitDir
+}
+
+// handleLocks applies opts.OnLocked to the polecat's worktree locks.
+// It returns the locks found (nil if unlocked) and a non-nil error if the
+// removal must not proceed.
+func (m *Manager) handleLocks(name string, opts RemoveOptions) ([]string, error) {
+	locks := m.WorktreeLocks(name)
+	if len(locks) == 0 {
+		return nil, nil
+	}
+
+	switch opts.OnLocked {
+	case LockWait:
+		timeout := opts.LockTimeout
+		if timeout <= 0 {
+			timeout = DefaultLockTimeout
+		}
+		for deadline := time.Now().Add(timeout); time.Now().Before(deadline); {
+			time.Sleep(lockPollInterval)
+			if len(m.WorktreeLocks(name)) == 0 {
+				return locks, nil
+			}
+		}
+		return locks, fmt.Errorf("%w: still locked after %s", ErrWorktreeLocked, timeout)
+
+	case LockForce:
+		for _, lock := range locks {
+			if err := os.Remove(lock); err != nil && !os.IsNotExist(err) {
+				return locks, fmt.Errorf("clearing %s: %w", filepath.Base(lock), err)
+			}
+		}
+		return locks, nil
+
+	default:
+		return locks, ErrWorktreeLocked
+	}
+}
@@ -0,0 +1,112 @@
+package polecat
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+// setupLockedPolecat creates a polecat whose worktree .git file points at a
+// git dir holding an index.lock. Returns the manager and the lock path.
+func setupLockedPolecat(t *testing.T, name string) (*Manager, string) {
+	t.Helper()
+	root := t.TempDir()
+	clone := filepath.Join(root, "polecats", name, "test-rig")
+	gitDir := filepath.Join(root, "mayor", "rig", ".git", "worktrees", name)
+	for _, dir := range []string{clone, gitDir} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(clone, ".git"), []byte("gitdir: "+gitDir+"\n"), 0644); err != nil {
+		t.Fatalf("write .git: %v", err)
+	}
+	lock := filepath.Join(gitDir, "index.lock")
+	if err := os.WriteFile(lock, nil, 0644); err != nil {
+		t.Fatalf("write lock: %v", err)
+	}
+
+	r := &rig.Rig{Name: "test-rig", Path: root}
+	return NewManager(r, git.NewGit(root)), lock
+}
+
+func TestParseLockPolicy(t *testing.T) {
+	for in, want := range map[string]LockPolicy{"": LockSkip, "skip": LockSkip, "wait": LockWait, "force": LockForce} {
+		if got, err := ParseLockPolicy(in); err != nil || got != want {
+			t.Errorf("ParseLockPolicy(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+	if _, err := ParseLockPolicy("retry"); err == nil {
+		t.Error("expected error for unknown policy")
+	}
+}
+
+func TestRemoveAllSkipsLockedWorktree(t *testing.T) {
+	m, lock := setupLockedPolecat(t, "Toast")
+
+	if locks := m.WorktreeLocks("Toast"); len(locks) != 1 || locks[0] != lock {
+		t.Fatalf("WorktreeLocks = %v, want [%s]", locks, lock)
+	}
+
+	var before []string
+	result, err := m.RemoveAll([]string{"Toast"}, RemoveOptions{Force: true,
+		BeforeRemove: func(name string) error { before = append(before, name); return nil }})
+	if err == nil {
+		t.Fatal("expected error for locked worktree")
+	}
+	if len(before) != 0 {
+		t.Errorf("BeforeRemove ran for skipped polecats %v", before)
+	}
+	o := result.Outcomes[0]
+	if !errors.Is(o.Err, ErrWorktreeLocked) || len(o.Locks) != 1 {
+		t.Errorf("outcome = %+v, want ErrWorktreeLocked with 1 lock", o)
+	}
+	if _, err := os.Stat(m.polecatDir("Toast")); err != nil {
+		t.Errorf("skipped polecat was removed: %v", err)
+	}
+}
+
+func TestRemoveAllWaitsForLock(t *testing.T) {
+	m, lock := setupLockedPolecat(t, "Toast")
+	oldInterval := lockPollInterval
+	lockPollInterval = 10 * time.Millisecond
+	defer func() { lockPollInterval = oldInterval }()
+
+	// Times out while the lock is held
+	result, _ := m.RemoveAll([]string{"Toast"}, RemoveOptions{Force: true, OnLocked: LockWait, LockTimeout: 30 * time.Millisecond})
+	if !errors.Is(result.Outcomes[0].Err, ErrWorktreeLocked) {
+		t.Fatalf("err = %v, want ErrWorktreeLocked after timeout", result.Outcomes[0].Err)
+	}
+
+	// Proceeds once the lock is released
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		_ = os.Remove(lock)
+	}()
+	result, err := m.RemoveAll([]string{"Toast"}, RemoveOptions{Force: true, OnLocked: LockWait, LockTimeout: 5 * time.Second})
+	if err != nil {
+		t.Fatalf("RemoveAll after lock release: %v", err)
+	}
+	if len(result.Outcomes[0].Locks) != 1 {
+		t.Errorf("Locks = %v, want the lock that was waited on", result.Outcomes[0].Locks)
+	}
+}
+
+func TestRemoveAllForceClearsLock(t *testing.T) {
+	m, lock := setupLockedPolecat(t, "Toast")
+
+	if _, err := m.RemoveAll([]string{"Toast"}, RemoveOptions{Force: true, OnLocked: LockForce}); err != nil {
+		t.Fatalf("RemoveAll: %v", err)
+	}
+	if _, err := os.Stat(lock); !os.IsNotExist(err) {
+		t.Error("lock file was not cleared")
+	}
+	if _, err := os.Stat(m.polecatDir("Toast")); !os.IsNotExist(err) {
+		t.Error("polecat was not removed")
+	}
+}
@@ -3,13 +3,16 @@
 package polecat
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/steveyegge/gastown/internal/beads"
@@ -25,8 +28,30 @@ var (
 	ErrPolecatNotFound   = errors.New("polecat not found")
 	ErrHasChanges        = errors.New("polecat has uncommitted changes")
 	ErrHasUncommittedWork = errors.New("polecat has uncommitted work")
+	ErrInvalidName       = errors.New("invalid polecat name")
 )
 
+// ValidateName checks that a polecat name is safe to use as a directory,
+// branch component, and session/agent ID suffix.
+func ValidateName(name string) error {
+	if name == "" {
+		return fmt.Errorf("%w: name cannot be empty", ErrInvalidName)
+	}
+	if strings.HasPrefix(name, ".") {
+		return fmt.Errorf("%w: %q cannot start with a dot", ErrInvalidName, name)
+	}
+	if strings.ContainsAny(name, "/\\") {
+		return fmt.Errorf("%w: %q contains path separators", ErrInvalidName, name)
+	}
+	if strings.ContainsAny(name, " \t\n:~^?*[") {
+		return fmt.Errorf("%w: %q contains characters not allowed in branch or session names", ErrInvalidName, name)
+	}
+	if isRigSingletonSession(name) {
+		return fmt.Errorf("%w: %q is reserved for rig agents", ErrInvalidName, name)
+	}
+	return nil
+}
+
 // UncommittedWorkError provides details about uncommitted work.
 type UncommittedWorkError struct {
 	PolecatName string
@@ -47,6 +72,10 @@ type Manager struct {
 	git      *git.Git
 	beads    *beads.Beads
 	namePool *NamePool
+
+	// mu serializes shared-state updates (name pool, worktree prune) when
+	// polecats are removed concurrently via RemoveAll.
+	mu sync.Mutex
 }
 
 // NewManager creates a new polecat manager.
@@ -54,7 +83,7 @@ func NewManager(r *rig.Rig, g *git.Git) *Manager {
 	// Use the resolved beads directory to find where bd commands should run.
 	// For tracked beads: rig/.beads/redirect -> mayor/rig/.beads, so use mayor/rig
 	// For local beads: rig/.beads is the database, so use rig root
-	resolvedBeads := beads.ResolveBeadsDir(r.Path)
+	resolvedBeads := r.BeadsDir()
 	beadsPath := filepath.Dir(resolvedBeads) // Get the directory containing .beads
 
 	// Try to load rig settings for namepool config
@@ -93,16 +122,57 @@ func (m *Manager) assigneeID(name string) string {
 
 // agentBeadID returns the agent bead ID for a polecat.
 // Format: "<prefix>-<rig>-polecat-<name>" (e.g., "gt-gastown-polecat-Toast", "bd-beads-polecat-obsidian")
-// The prefix is looked up from routes.jsonl to support rigs with custom prefixes.
+// unless the town configures a different scheme; see IDSchemeForRig.
 func (m *Manager) agentBeadID(name string) string {
+	return AgentBeadID(m.rig, name)
+}
+
+// AgentBeadID returns the agent bead ID for polecat name in rig r under the
+// town's configured bead ID scheme. If the town's bead_id_scheme is invalid
+// a warning is printed (once per problem) and the default scheme is used;
+// callers that can fail should use IDSchemeForRig and report the error.
+func AgentBeadID(r *rig.Rig, name string) string {
+	scheme, err := IDSchemeForRig(r)
+	if err != nil {
+		warnIDScheme(err)
+	}
+	return scheme.PolecatID(r.Name, name)
+}
+
+// idSchemeWarned holds the bead ID scheme errors already reported.
+var idSchemeWarned sync.Map
+
+func warnIDScheme(err error) {
+	if _, seen := idSchemeWarned.LoadOrStore(err.Error(), true); !seen {
+		fmt.Fprintf(os.Stderr, "warning: %v; using the default agent bead IDs\n", err)
+	}
+}
+
+// IDSchemeForRig returns the bead ID scheme for polecats in r: the town's
+// bead_id_scheme setting with the rig's prefix from routes.jsonl. Outside a
+// town the default scheme is used. If the town settings can't be read or
+// the setting is invalid, the error is returned along with the default
+// scheme, so a bad config is reported rather than silently targeting
+// differently named beads.
+func IDSchemeForRig(r *rig.Rig) (beads.IDScheme, error) {
 	// Find town root to lookup prefix from routes.jsonl
-	townRoot, err := workspace.Find(m.rig.Path)
+	townRoot, err := workspace.Find(r.Path)
 	if err != nil || townRoot == "" {
 		// Fall back to default prefix
-		return beads.PolecatBeadID(m.rig.Name, name)
+		return beads.DefaultIDScheme{}, nil
 	}
-	prefix := beads.GetPrefixForRig(townRoot, m.rig.Name)
-	return beads.PolecatBeadIDWithPrefix(prefix, m.rig.Name, name)
+	prefix := beads.GetPrefixForRig(townRoot, r.Name)
+
+	settingsPath := config.TownSettingsPath(townRoot)
+	settings, err := config.LoadOrCreateTownSettings(settingsPath)
+	if err != nil {
+		return beads.DefaultIDScheme{Prefix: prefix}, fmt.Errorf("loading %s: %w", settingsPath, err)
+	}
+	scheme, err := beads.NewIDScheme(settings.BeadIDScheme, prefix)
+	if err != nil {
+		return beads.DefaultIDScheme{Prefix: prefix}, fmt.Errorf("invalid bead_id_scheme in %s: %w", settingsPath, err)
+	}
+	return scheme, nil
 }
 
 // getCleanupStatusFromBead reads the cleanup_status from the polecat's agent bead.
@@ -237,6 +307,9 @@ func (m *Manager) Add(name string) (*Polecat, error) {
 // This allows setting hook_bead atomically at creation time, avoiding
 // cross-beads routing issues when slinging work to new polecats.
 func (m *Manager) AddWithOptions(name string, opts AddOptions) (*Polecat, error) {
+	if err := ValidateName(name); err != nil {
+		return nil, err
+	}
 	if m.exists(name) {
 		return nil, ErrPolecatExists
 	}
@@ -354,6 +427,13 @@ func (m *Manager) Remove(name string, force bool) error {
 // ZFC #10: Uses cleanup_status from agent bead if available (polecat self-report),
 // falls back to git check for backward compatibility.
 func (m *Manager) RemoveWithOptions(name string, force, nuclear bool) error {
+	return m.removeWithContext(context.Background(), name, force, nuclear)
+}
+
+// removeWithContext is RemoveWithOptions whose git commands are killed once
+// ctx is done. A removal cut short this way returns ctx.Err() rather than
+// falling back to deleting the directory.
+func (m *Manager) removeWithContext(ctx context.Context, name string, force, nuclear bool) error {
 	if !m.exists(name) {
 		return ErrPolecatNotFound
 	}
@@ -376,7 +456,7 @@ func (m *Manager) RemoveWithOptions(name string, force, nuclear bool) error {
 			}
 		} else {
 			// Fallback path: Check git directly (for polecats that haven't reported yet)
-			polecatGit := git.NewGit(clonePath)
+			polecatGit := git.NewGit(clonePath).WithContext(ctx)
 			status, err := polecatGit.CheckUncommittedWork()
 			if err == nil && !status.Clean() {
 				// For backward compatibility: force only bypasses uncommitted changes, not stashes/unpushed
@@ -392,18 +472,26 @@ func (m *Manager) RemoveWithOptions(name string, force, nuclear bool) error {
 		}
 	}
 
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	// Get repo base to remove the worktree properly
 	repoGit, err := m.repoBase()
 	if err != nil {
 		// Fall back to direct removal if repo base not found
-		return os.RemoveAll(polecatDir)
+		return removeAllBounded(ctx, polecatDir)
 	}
+	repoGit = repoGit.WithContext(ctx)
 
 	// Try to remove as a worktree first (use force flag for worktree removal too)
 	if err := repoGit.WorktreeRemove(clonePath, force); err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
 		// Fall back to direct removal if worktree removal fails
 		// (e.g., if this is an old-style clone, not a worktree)
-		if removeErr := os.RemoveAll(clonePath); removeErr != nil {
+		if removeErr := removeAllBounded(ctx, clonePath); removeErr != nil {
 			return fmt.Errorf("removing clone path: %w", removeErr)
 		}
 	}
@@ -414,12 +502,14 @@ func (m *Manager) RemoveWithOptions(name string, force, nuclear bool) error {
 		_ = os.Remove(polecatDir) // Non-fatal: only removes if empty
 	}
 
+	m.mu.Lock()
 	// Prune any stale worktree entries (non-fatal: cleanup only)
 	_ = repoGit.WorktreePrune()
 
 	// Release name back to pool if it's a pooled name (non-fatal: state file update)
 	m.namePool.Release(name)
 	_ = m.namePool.Save()
+	m.mu.Unlock()
 
 	// Delete agent bead (non-fatal: may not exist or beads may not be available)
 	agentID := m.agentBeadID(name)
@@ -613,7 +703,8 @@ func (m *Manager) PoolStatus() (active int, names []string) {
 	return m.namePool.ActiveCount(), m.namePool.ActiveNames()
 }
 
-// List returns all polecats in the rig.
+// List returns all polecats in the rig, sorted by name so that listings,
+// cleanup runs, and their JSON output are reproducible.
 func (m *Manager) List() ([]*Polecat, error) {
 	polecatsDir := filepath.Join(m.rig.Path, "polecats")
 
@@ -641,6 +732,7 @@ func (m *Manager) List() ([]*Polecat, error) {
 		polecats = append(polecats, polecat)
 	}
 
+	sort.Slice(polecats, func(i, j int) bool { return polecats[i].Name < polecats[j].Name })
 	return polecats, nil
 }
 
@@ -806,6 +898,21 @@ func (m *Manager) loadFromBeads(name string) (*Polecat, error) {
 	}, nil
 }
 
+// StateChangedAt returns when the polecat last changed state, taken from its
+// agent bead's updated_at. Falls back to the worktree's modification time when
+// the bead is unavailable. Returns the zero time if neither can be read.
+func (m *Manager) StateChangedAt(name string) time.Time {
+	if issue, _, err := m.beads.GetAgentBead(m.agentBeadID(name)); err == nil && issue != nil {
+		if t, err := time.Parse(time.RFC3339, issue.UpdatedAt); err == nil {
+			return t
+		}
+	}
+	if info, err := os.Stat(m.clonePath(name)); err == nil {
+		return info.ModTime()
+	}
+	return time.Time{}
+}
+
 // setupSharedBeads creates a redirect file so the polecat uses the rig's shared .beads database.
 // This eliminates the need for git sync between polecat clones - all polecats share one database.
 func (m *Manager) setupSharedBeads(clonePath string) error {
@@ -813,31 +920,107 @@ func (m *Manager) setupSharedBeads(clonePath string) error {
 	return beads.SetupRedirect(townRoot, clonePath)
 }
 
-// CleanupStaleBranches removes orphaned polecat branches that are no longer in use.
-// This includes:
-// - Branches for polecats that no longer exist
-// - Old timestamped branches (keeps only the most recent per polecat name)
-// Returns the number of branches deleted.
-func (m *Manager) CleanupStaleBranches() (int, error) {
+// Branches returns every local branch of the rig's repository, not just
+// polecat branches.
+func (m *Manager) Branches() ([]string, error) {
 	repoGit, err := m.repoBase()
 	if err != nil {
-		return 0, fmt.Errorf("finding repo base: %w", err)
+		return nil, fmt.Errorf("finding repo base: %w", err)
+	}
+	branches, err := repoGit.ListBranches("")
+	if err != nil {
+		return nil, fmt.Errorf("listing branches: %w", err)
+	}
+	return branches, nil
+}
+
+// PolecatBranch is a polecat branch with its merge status and the polecat
+// using it, if any.
+type PolecatBranch struct {
+	Branch  string `json:"branch"`
+	Merged  bool   `json:"merged"`            // Tip is contained in the rig's default branch
+	Polecat string `json:"polecat,omitempty"` // Empty when no polecat uses the branch
+	State   State  `json:"state,omitempty"`   // The polecat's state
+}
+
+// PolecatBranches joins List with the merge status of each polecat's branch
+// and of the polecat branches no polecat uses (the ones StaleBranches
+// reports). Returns the branches and the default branch ref they were
+// checked against.
+func (m *Manager) PolecatBranches() ([]PolecatBranch, string, error) {
+	repoGit, err := m.repoBase()
+	if err != nil {
+		return nil, "", fmt.Errorf("finding repo base: %w", err)
+	}
+	branches, err := repoGit.ListBranches("polecat/*")
+	if err != nil {
+		return nil, "", fmt.Errorf("listing branches: %w", err)
+	}
+	polecats, err := m.List()
+	if err != nil {
+		return nil, "", fmt.Errorf("listing polecats: %w", err)
+	}
+
+	baseRef := m.defaultBranchRef(repoGit)
+	merged := func(branch string) bool {
+		// Treat merge-check errors as unmerged, as StaleBranches does
+		ok, err := repoGit.IsAncestor(branch, baseRef)
+		return err == nil && ok
+	}
+	return joinPolecatBranches(polecats, branches, merged), baseRef, nil
+}
+
+// joinPolecatBranches lists each polecat's branch (in polecat order), then
+// the branches no polecat uses (in branch order).
+func joinPolecatBranches(polecats []*Polecat, branches []string, merged func(string) bool) []PolecatBranch {
+	var out []PolecatBranch
+	used := make(map[string]bool)
+	for _, p := range polecats {
+		if p.Branch == "" {
+			continue
+		}
+		used[p.Branch] = true
+		out = append(out, PolecatBranch{Branch: p.Branch, Merged: merged(p.Branch), Polecat: p.Name, State: p.State})
+	}
+	for _, branch := range branches {
+		if !used[branch] {
+			out = append(out, PolecatBranch{Branch: branch, Merged: merged(branch)})
+		}
+	}
+	return out
+}
+
+// StaleBranch describes a polecat branch that no existing polecat uses.
+type StaleBranch struct {
+	Name   string // Branch name (e.g., "polecat/Toast-1234")
+	Merged bool   // Tip is contained in the rig's default branch
+}
+
+// StaleBranches returns polecat branches that no existing polecat uses,
+// along with whether each one's tip is already contained in the rig's
+// default branch. Merge status is checked with IsAncestor against the
+// default branch itself, so it does not depend on what is checked out.
+func (m *Manager) StaleBranches() ([]StaleBranch, string, error) {
+	repoGit, err := m.repoBase()
+	if err != nil {
+		return nil, "", fmt.Errorf("finding repo base: %w", err)
 	}
 
 	// List all polecat branches
 	branches, err := repoGit.ListBranches("polecat/*")
 	if err != nil {
-		return 0, fmt.Errorf("listing branches: %w", err)
+		return nil, "", fmt.Errorf("listing branches: %w", err)
 	}
 
+	baseRef := m.defaultBranchRef(repoGit)
 	if len(branches) == 0 {
-		return 0, nil
+		return nil, baseRef, nil
 	}
 
 	// Get list of existing polecats
 	polecats, err := m.List()
 	if err != nil {
-		return 0, fmt.Errorf("listing polecats: %w", err)
+		return nil, baseRef, fmt.Errorf("listing polecats: %w", err)
 	}
 
 	// Build set of current polecat branches (from actual polecat objects)
@@ -846,37 +1029,93 @@ func (m *Manager) CleanupStaleBranches() (int, error) {
 		currentBranches[p.Branch] = true
 	}
 
-	// Delete branches not in current set
-	deleted := 0
+	var stale []StaleBranch
 	for _, branch := range branches {
 		if currentBranches[branch] {
 			continue // This branch is in use
 		}
-		// Delete orphaned branch
-		if err := repoGit.DeleteBranch(branch, true); err != nil {
-			// Log but continue - non-fatal
-			fmt.Printf("Warning: could not delete branch %s: %v\n", branch, err)
+		// Treat merge-check errors as unmerged so GC errs on the side of keeping work
+		merged, err := repoGit.IsAncestor(branch, baseRef)
+		stale = append(stale, StaleBranch{Name: branch, Merged: err == nil && merged})
+	}
+
+	return stale, baseRef, nil
+}
+
+// defaultBranchRef returns the ref that stale-branch GC compares against.
+// Uses the registry setting (gt rig default-branch), then the rig's config.json,
+// then origin/HEAD, and prefers the remote-tracking ref when it exists.
+func (m *Manager) defaultBranchRef(repoGit *git.Git) string {
+	defaultBranch, _ := m.rig.ConfiguredDefaultBranch()
+	if defaultBranch == "" {
+		defaultBranch = repoGit.RemoteDefaultBranch()
+	}
+
+	remoteRef := "origin/" + defaultBranch
+	if _, err := repoGit.Rev(remoteRef); err == nil {
+		return remoteRef
+	}
+	return defaultBranch
+}
+
+// CleanupStaleBranches removes orphaned polecat branches that are no longer in use.
+// Only branches whose tip is already contained in the rig's default branch are
+// deleted; orphaned branches with unmerged work are kept.
+// Returns the number of branches deleted, and one error per branch that
+// couldn't be deleted; those don't stop the rest and are left to the caller
+// to report.
+func (m *Manager) CleanupStaleBranches() (int, []error, error) {
+	return m.CleanupStaleBranchesContext(context.Background())
+}
+
+// CleanupStaleBranchesContext is CleanupStaleBranches with cancellation: once
+// ctx is done, no further branches are deleted and ctx.Err() is returned
+// along with the count deleted so far.
+func (m *Manager) CleanupStaleBranchesContext(ctx context.Context) (deleted int, failed []error, err error) {
+	repoGit, err := m.repoBase()
+	if err != nil {
+		return 0, nil, fmt.Errorf("finding repo base: %w", err)
+	}
+
+	stale, _, err := m.StaleBranches()
+	if err != nil {
+		return 0, nil, err
+	}
+
+	// Delete merged branches not in current set
+	for _, branch := range stale {
+		if !branch.Merged {
+			continue // Unmerged work - keep it
+		}
+		if err := ctx.Err(); err != nil {
+			return deleted, failed, err
+		}
+		if err := repoGit.DeleteBranch(branch.Name, true); err != nil {
+			// Non-fatal: keep going with the other branches
+			failed = append(failed, fmt.Errorf("could not delete branch %s: %w", branch.Name, err))
 			continue
 		}
 		deleted++
 	}
 
-	return deleted, nil
+	return deleted, failed, nil
 }
 
 // StalenessInfo contains details about a polecat's staleness.
 type StalenessInfo struct {
-	Name            string
-	CommitsBehind   int  // How many commits behind origin/main
-	HasActiveSession bool // Whether tmux session is running
-	HasUncommittedWork bool // Whether there's uncommitted or unpushed work
-	AgentState      string // From agent bead (empty if no bead)
-	IsStale         bool   // Overall assessment: safe to clean up
-	Reason          string // Why it's considered stale (or not)
+	Name               string
+	State              State
+	CommitsBehind      int    // How many commits behind origin/main
+	HasActiveSession   bool   // Whether tmux session is running
+	HasUncommittedWork bool   // Whether there's uncommitted or unpushed work
+	AgentState         string // From agent bead (empty if no bead)
+	IsStale            bool   // Overall assessment: safe to clean up
+	Reason             string // Why it's considered stale (or not)
 }
 
 // DetectStalePolecats identifies polecats that are candidates for cleanup.
 // A polecat is considered stale if:
+// - It is not done (done polecats are reaped by 'gt cleanup', see IsDone) AND
 // - No active tmux session AND
 // - Either: way behind main (>threshold commits) OR no agent bead/activity
 // - Has no uncommitted work that could be lost
@@ -887,26 +1126,27 @@ func (m *Manager) DetectStalePolecats(threshold int) ([]*StalenessInfo, error) {
 	if err != nil {
 		return nil, fmt.Errorf("listing polecats: %w", err)
 	}
+	return m.AssessStaleness(polecats, threshold), nil
+}
 
+// AssessStaleness returns the staleness of each of polecats, by the rules of
+// DetectStalePolecats.
+func (m *Manager) AssessStaleness(polecats []*Polecat, threshold int) []*StalenessInfo {
 	if len(polecats) == 0 {
-		return nil, nil
+		return nil
 	}
 
-	// Get default branch from rig config
-	defaultBranch := "main"
-	if rigCfg, err := rig.LoadRigConfig(m.rig.Path); err == nil && rigCfg.DefaultBranch != "" {
-		defaultBranch = rigCfg.DefaultBranch
-	}
+	defaultBranch := m.rig.DefaultBranch()
 
 	var results []*StalenessInfo
 	for _, p := range polecats {
 		info := &StalenessInfo{
-			Name: p.Name,
+			Name:  p.Name,
+			State: p.State,
 		}
 
 		// Check for active tmux session
-		// Session name follows pattern: gt-<rig>-<polecat>
-		sessionName := fmt.Sprintf("gt-%s-%s", m.rig.Name, p.Name)
+		sessionName := SessionName(m.rig.Name, p.Name)
 		info.HasActiveSession = checkTmuxSession(sessionName)
 
 		// Check how far behind main
@@ -931,7 +1171,7 @@ func (m *Manager) DetectStalePolecats(threshold int) ([]*StalenessInfo, error) {
 		results = append(results, info)
 	}
 
-	return results, nil
+	return results
 }
 
 // checkTmuxSession checks if a tmux session exists.
@@ -957,6 +1197,11 @@ func countCommitsBehind(g *git.Git, defaultBranch string) int {
 // Per gt-zecmc: uses tmux state (HasActiveSession) rather than agent_state
 // since observable states (running, done, idle) are no longer recorded in beads.
 func assessStaleness(info *StalenessInfo, threshold int) (bool, string) {
+	// Done polecats are finished, not abandoned; gt cleanup reaps them
+	if info.State == StateDone {
+		return false, "done (reaped by gt cleanup)"
+	}
+
 	// Never clean up if there's uncommitted work
 	if info.HasUncommittedWork {
 		return false, "has uncommitted work"
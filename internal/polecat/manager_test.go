@@ -1,9 +1,12 @@
 package polecat
 
 import (
+	"encoding/json"
+	"errors"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/steveyegge/gastown/internal/git"
@@ -139,6 +142,40 @@ func TestAssigneeID(t *testing.T) {
 	}
 }
 
+func TestAgentBeadIDUsesTownScheme(t *testing.T) {
+	root := t.TempDir()
+	for _, dir := range []string{"mayor", "settings", "gastown"} {
+		if err := os.MkdirAll(filepath.Join(root, dir), 0755); err != nil {
+			t.Fatalf("mkdir %s: %v", dir, err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(root, "mayor", "town.json"), []byte(`{"type":"town"}`), 0644); err != nil {
+		t.Fatalf("write town.json: %v", err)
+	}
+	r := &rig.Rig{Name: "gastown", Path: filepath.Join(root, "gastown")}
+
+	// No setting: the default scheme
+	if got := AgentBeadID(r, "Toast"); got != "gt-gastown-polecat-Toast" {
+		t.Errorf("default AgentBeadID = %q, want gt-gastown-polecat-Toast", got)
+	}
+
+	settings := `{"type":"town-settings","version":1,"bead_id_scheme":"{prefix}-pc-{rig}-{name}"}`
+	if err := os.WriteFile(filepath.Join(root, "settings", "config.json"), []byte(settings), 0644); err != nil {
+		t.Fatalf("write settings: %v", err)
+	}
+	if got := AgentBeadID(r, "Toast"); got != "gt-pc-gastown-Toast" {
+		t.Errorf("configured AgentBeadID = %q, want gt-pc-gastown-Toast", got)
+	}
+	scheme, err := IDSchemeForRig(r)
+	if err != nil {
+		t.Fatalf("IDSchemeForRig: %v", err)
+	}
+	rigName, name, ok := scheme.ParsePolecatID("gt-pc-gastown-Toast")
+	if !ok || rigName != "gastown" || name != "Toast" {
+		t.Errorf("ParsePolecatID = %q, %q, %v", rigName, name, ok)
+	}
+}
+
 // Note: State persistence tests removed - state is now derived from beads assignee field.
 // Integration tests should verify beads-based state management.
 
@@ -218,6 +255,33 @@ func TestListWithPolecats(t *testing.T) {
 	}
 }
 
+func TestListSortedByName(t *testing.T) {
+	root := t.TempDir()
+	names := []string{"Toast", "Nux", "Ace", "Cheedo", "Furiosa"}
+	for _, name := range names {
+		if err := os.MkdirAll(filepath.Join(root, "polecats", name), 0755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+	}
+	if err := os.MkdirAll(filepath.Join(root, "mayor", "rig"), 0755); err != nil {
+		t.Fatalf("mkdir mayor/rig: %v", err)
+	}
+	m := NewManager(&rig.Rig{Name: "test-rig", Path: root}, git.NewGit(root))
+
+	polecats, err := m.List()
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	var got []string
+	for _, p := range polecats {
+		got = append(got, p.Name)
+	}
+	want := []string{"Ace", "Cheedo", "Furiosa", "Nux", "Toast"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("List order = %v, want %v", got, want)
+	}
+}
+
 // Note: TestSetState, TestAssignIssue, and TestClearIssue were removed.
 // These operations now require a running beads instance and are tested
 // via integration tests. The unit tests here focus on testing the basic
@@ -279,3 +343,117 @@ func TestClearIssueWithoutAssignment(t *testing.T) {
 // We no longer write CLAUDE.md to worktrees - Gas Town context is injected
 // ephemerally via SessionStart hook (gt prime) to prevent leaking internal
 // architecture into project repos.
+
+func TestValidateName(t *testing.T) {
+	valid := []string{"Toast", "polecat-01", "gastown-7", "nux"}
+	for _, name := range valid {
+		if err := ValidateName(name); err != nil {
+			t.Errorf("ValidateName(%q) = %v, want nil", name, err)
+		}
+	}
+
+	invalid := []string{"", ".hidden", "a/b", `a\b`, "has space", "bad:name", "witness", "refinery", "crew-joe"}
+	for _, name := range invalid {
+		if err := ValidateName(name); !errors.Is(err, ErrInvalidName) {
+			t.Errorf("ValidateName(%q) = %v, want ErrInvalidName", name, err)
+		}
+	}
+}
+
+func TestJoinPolecatBranches(t *testing.T) {
+	polecats := []*Polecat{
+		{Name: "Nux", State: StateDone, Branch: "polecat/Nux-1"},
+		{Name: "Toast", State: StateWorking, Branch: "polecat/Toast-2"},
+		{Name: "Slit", State: StateWorking}, // No branch recorded
+	}
+	branches := []string{"polecat/Nux-1", "polecat/Old-3", "polecat/Toast-2", "polecat/Wip-4"}
+	merged := func(b string) bool { return b != "polecat/Wip-4" }
+
+	got := joinPolecatBranches(polecats, branches, merged)
+	want := []PolecatBranch{
+		{Branch: "polecat/Nux-1", Merged: true, Polecat: "Nux", State: StateDone},
+		{Branch: "polecat/Toast-2", Merged: true, Polecat: "Toast", State: StateWorking},
+		{Branch: "polecat/Old-3", Merged: true},
+		{Branch: "polecat/Wip-4"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("branch %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestIDSchemeForRigReportsInvalidScheme(t *testing.T) {
+	root := t.TempDir()
+	for _, dir := range []string{"mayor", "settings", "gastown"} {
+		if err := os.MkdirAll(filepath.Join(root, dir), 0755); err != nil {
+			t.Fatalf("mkdir %s: %v", dir, err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(root, "mayor", "town.json"), []byte(`{"type":"town"}`), 0644); err != nil {
+		t.Fatalf("write town.json: %v", err)
+	}
+	settings := `{"type":"town-settings","version":1,"bead_id_scheme":"{prefix}-{name}-{name}"}`
+	if err := os.WriteFile(filepath.Join(root, "settings", "config.json"), []byte(settings), 0644); err != nil {
+		t.Fatalf("write settings: %v", err)
+	}
+	r := &rig.Rig{Name: "gastown", Path: filepath.Join(root, "gastown")}
+
+	scheme, err := IDSchemeForRig(r)
+	if err == nil || !strings.Contains(err.Error(), "bead_id_scheme") {
+		t.Fatalf("err = %v, want an invalid bead_id_scheme error", err)
+	}
+	if got := scheme.PolecatID("gastown", "Toast"); got != "gt-gastown-polecat-Toast" {
+		t.Errorf("fallback PolecatID = %q, want the default scheme", got)
+	}
+}
+
+func TestStalenessInfoJSONKeys(t *testing.T) {
+	// 'gt polecat stale --json' output; scripts depend on these keys
+	data, err := json.Marshal(&StalenessInfo{Name: "Toast", IsStale: true, Reason: "no session"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{`"Name":"Toast"`, `"CommitsBehind":0`, `"HasActiveSession":false`,
+		`"HasUncommittedWork":false`, `"AgentState":""`, `"IsStale":true`, `"Reason":"no session"`} {
+		if !strings.Contains(string(data), key) {
+			t.Errorf("JSON %s missing %s", data, key)
+		}
+	}
+}
+
+func TestCleanupStaleBranchesReturnsDeleteFailures(t *testing.T) {
+	root := t.TempDir()
+	repo := filepath.Join(root, "mayor", "rig")
+	if err := os.MkdirAll(repo, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for _, args := range [][]string{
+		{"init", "-q", "-b", "main"},
+		{"-c", "user.email=t@t", "-c", "user.name=t", "commit", "-q", "--allow-empty", "-m", "init"},
+		{"branch", "polecat/Gone"},
+		// A merged branch checked out elsewhere can't be deleted
+		{"worktree", "add", "-q", filepath.Join(root, "elsewhere"), "-b", "polecat/Busy"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = repo
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+
+	m := NewManager(&rig.Rig{Name: "test-rig", Path: root}, git.NewGit(root))
+	deleted, failed, err := m.CleanupStaleBranches()
+	if err != nil {
+		t.Fatalf("CleanupStaleBranches: %v", err)
+	}
+	if deleted != 1 {
+		t.Errorf("deleted = %d, want 1", deleted)
+	}
+	if len(failed) != 1 || !strings.Contains(failed[0].Error(), "polecat/Busy") {
+		t.Errorf("failed = %v, want the checked-out polecat/Busy", failed)
+	}
+}
@@ -0,0 +1,205 @@
+package polecat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// RemoveOptions configures batch polecat removal.
+type RemoveOptions struct {
+	Force   bool // Bypass uncommitted changes check (see RemoveWithOptions)
+	Nuclear bool // Bypass ALL safety checks
+	Jobs    int  // Max concurrent removals; <= 1 removes sequentially
+
+	// OnLocked decides what happens to a worktree locked by git; the zero
+	// value skips it. LockTimeout bounds LockWait (DefaultLockTimeout if 0).
+	OnLocked    LockPolicy
+	LockTimeout time.Duration
+
+	// Timeout bounds each polecat's removal; 0 means no limit. A removal that
+	// runs over has its git commands killed and fails with ErrRemoveTimeout,
+	// and the batch moves on.
+	Timeout time.Duration
+
+	// BeforeRemove, if set, runs for each polecat that lock handling has
+	// cleared, just before it is removed. An error keeps the polecat and
+	// becomes its outcome's Err.
+	BeforeRemove func(name string) error
+}
+
+// ErrRemoveTimeout is returned for a polecat whose removal exceeded
+// RemoveOptions.Timeout.
+var ErrRemoveTimeout = errors.New("polecat removal timed out")
+
+// RemoveOutcome is the result of removing a single polecat.
+type RemoveOutcome struct {
+	Name      string
+	Reclaimed int64    // Bytes in the polecat directory before removal
+	Locks     []string // Git lock files found on the worktree, if any
+	Err       error
+}
+
+// RemoveResult aggregates the outcomes of a batch removal.
+type RemoveResult struct {
+	Outcomes  []RemoveOutcome // One per requested name, in input order
+	Reclaimed int64           // Total bytes reclaimed by successful removals
+}
+
+// Removed returns the names that were removed successfully.
+func (r RemoveResult) Removed() []string {
+	var names []string
+	for _, o := range r.Outcomes {
+		if o.Err == nil {
+			names = append(names, o.Name)
+		}
+	}
+	return names
+}
+
+// Failed returns the outcomes of removals that failed.
+func (r RemoveResult) Failed() []RemoveOutcome {
+	var failed []RemoveOutcome
+	for _, o := range r.Outcomes {
+		if o.Err != nil {
+			failed = append(failed, o)
+		}
+	}
+	return failed
+}
+
+// RemoveAll removes several polecats, optionally in parallel (opts.Jobs).
+// Every name is attempted; per-name failures are reported in the result.
+// Worktrees locked by git are handled per opts.OnLocked, and the locks found
+// are recorded in the outcome.
+// The returned error is non-nil if any removal failed.
+func (m *Manager) RemoveAll(names []string, opts RemoveOptions) (RemoveResult, error) {
+	return m.RemoveAllContext(context.Background(), names, opts)
+}
+
+// RemoveAllContext is RemoveAll with cancellation. Once ctx is done, removals
+// already in progress finish but no new ones start; the skipped polecats'
+// outcomes carry ctx.Err().
+func (m *Manager) RemoveAllContext(ctx context.Context, names []string, opts RemoveOptions) (RemoveResult, error) {
+	result := RemoveResult{Outcomes: make([]RemoveOutcome, len(names))}
+
+	jobs := opts.Jobs
+	if jobs < 1 {
+		jobs = 1
+	}
+
+	sem := make(chan struct{}, jobs)
+	var wg sync.WaitGroup
+	for i, name := range names {
+		sem <- struct{}{}
+		if err := ctx.Err(); err != nil {
+			<-sem
+			result.Outcomes[i] = RemoveOutcome{Name: name, Err: err}
+			continue
+		}
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			locks, err := m.handleLocks(name, opts)
+			if err == nil && opts.BeforeRemove != nil {
+				err = opts.BeforeRemove(name)
+			}
+			if err != nil {
+				result.Outcomes[i] = RemoveOutcome{Name: name, Locks: locks, Err: err}
+				return
+			}
+
+			size, err := m.removeWithTimeout(name, opts)
+			result.Outcomes[i] = RemoveOutcome{Name: name, Locks: locks, Err: err}
+			if err == nil {
+				result.Outcomes[i].Reclaimed = size
+			}
+		}(i, name)
+	}
+	wg.Wait()
+
+	for _, o := range result.Outcomes {
+		result.Reclaimed += o.Reclaimed
+	}
+
+	if failed := result.Failed(); len(failed) > 0 {
+		return result, fmt.Errorf("%d of %s could not be removed", len(failed), style.Count(len(names), "polecat", "polecats"))
+	}
+	return result, nil
+}
+
+// removeWithTimeout measures and removes one polecat, both bounded by
+// opts.Timeout, and returns the bytes its worktree held. The removal is
+// deliberately not tied to RemoveAllContext's ctx: an interrupt lets an
+// in-flight removal finish rather than leaving it half done.
+func (m *Manager) removeWithTimeout(name string, opts RemoveOptions) (int64, error) {
+	if opts.Timeout <= 0 {
+		size := dirSize(context.Background(), m.polecatDir(name))
+		return size, m.RemoveWithOptions(name, opts.Force, opts.Nuclear)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), opts.Timeout)
+	defer cancel()
+
+	var size int64
+	err := runBounded(ctx, func() error {
+		size = dirSize(ctx, m.polecatDir(name))
+		return nil
+	})
+	if err == nil {
+		err = m.removeWithContext(ctx, name, opts.Force, opts.Nuclear)
+	}
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return 0, fmt.Errorf("%w after %s", ErrRemoveTimeout, opts.Timeout)
+	}
+	return size, err
+}
+
+// runBounded runs fn but stops waiting for it once ctx is done, returning
+// ctx.Err(). Filesystem calls can't be interrupted, so on a hung mount fn is
+// abandoned in its goroutine rather than stalling the caller.
+func runBounded(ctx context.Context, fn func() error) error {
+	done := make(chan error, 1)
+	go func() { done <- fn() }()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// removeAllBounded is os.RemoveAll bounded by ctx (see runBounded).
+func removeAllBounded(ctx context.Context, path string) error {
+	return runBounded(ctx, func() error { return os.RemoveAll(path) })
+}
+
+// dirSize returns the total size of regular files under path, stopping
+// early once ctx is done. Unreadable entries are skipped; a missing path
+// has size 0.
+func dirSize(ctx context.Context, path string) int64 {
+	var size int64
+	_ = filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err != nil {
+			return nil
+		}
+		if d.Type().IsRegular() {
+			if info, err := d.Info(); err == nil {
+				size += info.Size()
+			}
+		}
+		return nil
+	})
+	return size
+}
@@ -0,0 +1,180 @@
+package polecat
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+func TestRemoveAll(t *testing.T) {
+	root := t.TempDir()
+	for _, name := range []string{"Toast", "Cheedo"} {
+		dir := filepath.Join(root, "polecats", name, "test-rig")
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "file.txt"), make([]byte, 100), 0644); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+	if err := os.MkdirAll(filepath.Join(root, "mayor", "rig"), 0755); err != nil {
+		t.Fatalf("mkdir mayor/rig: %v", err)
+	}
+
+	r := &rig.Rig{Name: "test-rig", Path: root}
+	m := NewManager(r, git.NewGit(root))
+
+	result, err := m.RemoveAll([]string{"Toast", "Missing", "Cheedo"}, RemoveOptions{Force: true, Jobs: 2})
+	if err == nil {
+		t.Error("expected error for missing polecat")
+	}
+
+	if len(result.Outcomes) != 3 {
+		t.Fatalf("outcomes = %d, want 3", len(result.Outcomes))
+	}
+	if result.Outcomes[1].Name != "Missing" || !errors.Is(result.Outcomes[1].Err, ErrPolecatNotFound) {
+		t.Errorf("outcome[1] = %+v, want Missing/ErrPolecatNotFound", result.Outcomes[1])
+	}
+	if removed := result.Removed(); len(removed) != 2 || removed[0] != "Toast" || removed[1] != "Cheedo" {
+		t.Errorf("Removed() = %v, want [Toast Cheedo]", removed)
+	}
+	if failed := result.Failed(); len(failed) != 1 {
+		t.Errorf("Failed() = %v, want 1 entry", failed)
+	}
+	if result.Reclaimed != 200 {
+		t.Errorf("Reclaimed = %d, want 200", result.Reclaimed)
+	}
+
+	for _, name := range []string{"Toast", "Cheedo"} {
+		if _, err := os.Stat(filepath.Join(root, "polecats", name)); !os.IsNotExist(err) {
+			t.Errorf("polecat %s still exists", name)
+		}
+	}
+}
+
+func TestRemoveAllBeforeRemoveKeepsPolecat(t *testing.T) {
+	root := t.TempDir()
+	for _, name := range []string{"Toast", "Cheedo"} {
+		if err := os.MkdirAll(filepath.Join(root, "polecats", name, "test-rig"), 0755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+	}
+	if err := os.MkdirAll(filepath.Join(root, "mayor", "rig"), 0755); err != nil {
+		t.Fatalf("mkdir mayor/rig: %v", err)
+	}
+	m := NewManager(&rig.Rig{Name: "test-rig", Path: root}, git.NewGit(root))
+
+	errSalvage := errors.New("salvage failed")
+	result, _ := m.RemoveAll([]string{"Toast", "Cheedo"}, RemoveOptions{Force: true,
+		BeforeRemove: func(name string) error {
+			if name == "Toast" {
+				return errSalvage
+			}
+			return nil
+		}})
+	if !errors.Is(result.Outcomes[0].Err, errSalvage) {
+		t.Errorf("Toast outcome = %+v, want the BeforeRemove error", result.Outcomes[0])
+	}
+	if _, err := os.Stat(filepath.Join(root, "polecats", "Toast")); err != nil {
+		t.Errorf("Toast removed despite BeforeRemove error: %v", err)
+	}
+	if removed := result.Removed(); len(removed) != 1 || removed[0] != "Cheedo" {
+		t.Errorf("Removed() = %v, want [Cheedo]", removed)
+	}
+}
+
+func TestRemoveAllContextCancelled(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, "polecats", "Toast", "test-rig")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+
+	r := &rig.Rig{Name: "test-rig", Path: root}
+	m := NewManager(r, git.NewGit(root))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	result, err := m.RemoveAllContext(ctx, []string{"Toast"}, RemoveOptions{Force: true})
+	if err == nil || !errors.Is(result.Outcomes[0].Err, context.Canceled) {
+		t.Errorf("outcome = %+v, want context.Canceled", result.Outcomes[0])
+	}
+	if _, err := os.Stat(dir); err != nil {
+		t.Errorf("polecat removed after cancel: %v", err)
+	}
+}
+
+func TestRemoveAllTimeout(t *testing.T) {
+	// A git that hangs, like one stuck on an NFS mount
+	bin := t.TempDir()
+	if err := os.WriteFile(filepath.Join(bin, "git"), []byte("#!/bin/sh\nexec sleep 10\n"), 0755); err != nil {
+		t.Fatalf("write fake git: %v", err)
+	}
+	t.Setenv("PATH", bin+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	root := t.TempDir()
+	dir := filepath.Join(root, "polecats", "Toast", "test-rig")
+	for _, d := range []string{dir, filepath.Join(root, "mayor", "rig")} {
+		if err := os.MkdirAll(d, 0755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+	}
+	m := NewManager(&rig.Rig{Name: "test-rig", Path: root}, git.NewGit(root))
+
+	start := time.Now()
+	result, _ := m.RemoveAll([]string{"Toast"}, RemoveOptions{Force: true, Timeout: 200 * time.Millisecond})
+	if !errors.Is(result.Outcomes[0].Err, ErrRemoveTimeout) {
+		t.Errorf("err = %v, want ErrRemoveTimeout", result.Outcomes[0].Err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("RemoveAll took %s; the hung git wasn't killed", elapsed)
+	}
+	if _, err := os.Stat(dir); err != nil {
+		t.Errorf("timed-out polecat was removed anyway: %v", err)
+	}
+}
+
+func TestRunBoundedAbandonsHungWork(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	// Stands in for a stat or unlink stuck on a hung mount
+	release := make(chan struct{})
+	defer close(release)
+	start := time.Now()
+	err := runBounded(ctx, func() error {
+		<-release
+		return nil
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("runBounded waited %s for hung work", elapsed)
+	}
+
+	if err := runBounded(context.Background(), func() error { return os.ErrPermission }); !errors.Is(err, os.ErrPermission) {
+		t.Errorf("err = %v, want fn's error", err)
+	}
+}
+
+func TestDirSizeStopsWhenCancelled(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "f"), make([]byte, 100), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if got := dirSize(context.Background(), dir); got != 100 {
+		t.Errorf("dirSize = %d, want 100", got)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if got := dirSize(ctx, dir); got != 0 {
+		t.Errorf("dirSize with cancelled ctx = %d, want 0", got)
+	}
+}
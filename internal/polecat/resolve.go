@@ -0,0 +1,66 @@
+package polecat
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+// AmbiguousNameError is returned by Resolve when a bare polecat name exists
+// in more than one rig.
+type AmbiguousNameError struct {
+	Name       string
+	Candidates []string // rig/name addresses, in rig order
+}
+
+func (e *AmbiguousNameError) Error() string {
+	return fmt.Sprintf("polecat name %q is ambiguous; use one of: %s", e.Name, strings.Join(e.Candidates, ", "))
+}
+
+// Resolve finds the polecat named by spec among the town's rigs. spec is
+// either "rig/name" or a bare "name". Polecat names have no slash, so the
+// rig is everything before the last one, which may itself be a nested rig
+// ("outer/inner/name"). A bare name must exist in exactly one rig; if
+// several rigs have a polecat by that name, an *AmbiguousNameError lists
+// the rig/name candidates.
+func Resolve(rigs []*rig.Rig, spec string) (*rig.Rig, string, error) {
+	if i := strings.LastIndex(spec, "/"); i >= 0 {
+		rigName, name := spec[:i], spec[i+1:]
+		if rigName == "" || name == "" {
+			return nil, "", fmt.Errorf("invalid address %q: expected 'rig/polecat'", spec)
+		}
+		for _, r := range rigs {
+			if r.Name == rigName {
+				return r, name, nil
+			}
+		}
+		return nil, "", fmt.Errorf("rig %q not found", rigName)
+	}
+	if spec == "" {
+		return nil, "", errors.New("polecat name required")
+	}
+
+	var matches []*rig.Rig
+	for _, r := range rigs {
+		if _, err := os.Stat(filepath.Join(r.Path, "polecats", spec)); err == nil {
+			matches = append(matches, r)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, "", fmt.Errorf("%w: %s (in any rig)", ErrPolecatNotFound, spec)
+	case 1:
+		return matches[0], spec, nil
+	default:
+		candidates := make([]string, len(matches))
+		for i, r := range matches {
+			candidates[i] = r.Name + "/" + spec
+		}
+		return nil, "", &AmbiguousNameError{Name: spec, Candidates: candidates}
+	}
+}
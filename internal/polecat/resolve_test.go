@@ -0,0 +1,78 @@
+package polecat
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+func TestResolve(t *testing.T) {
+	root := t.TempDir()
+	var rigs []*rig.Rig
+	for rigName, polecats := range map[string][]string{
+		"alpha": {"Toast", "Nux"},
+		"bravo": {"Toast"},
+	} {
+		r := &rig.Rig{Name: rigName, Path: filepath.Join(root, rigName)}
+		for _, name := range polecats {
+			if err := os.MkdirAll(filepath.Join(r.Path, "polecats", name), 0755); err != nil {
+				t.Fatalf("mkdir: %v", err)
+			}
+		}
+		rigs = append(rigs, r)
+	}
+	rig.SortByPriority(rigs)
+
+	// Explicit rig/name
+	r, name, err := Resolve(rigs, "bravo/Toast")
+	if err != nil || r.Name != "bravo" || name != "Toast" {
+		t.Errorf("Resolve(bravo/Toast) = %v, %q, %v", r, name, err)
+	}
+
+	// Unique bare name
+	r, name, err = Resolve(rigs, "Nux")
+	if err != nil || r.Name != "alpha" || name != "Nux" {
+		t.Errorf("Resolve(Nux) = %v, %q, %v", r, name, err)
+	}
+
+	// Ambiguous bare name
+	_, _, err = Resolve(rigs, "Toast")
+	var ambiguous *AmbiguousNameError
+	if !errors.As(err, &ambiguous) {
+		t.Fatalf("Resolve(Toast) err = %v, want AmbiguousNameError", err)
+	}
+	if want := []string{"alpha/Toast", "bravo/Toast"}; !reflect.DeepEqual(ambiguous.Candidates, want) {
+		t.Errorf("candidates = %v, want %v", ambiguous.Candidates, want)
+	}
+
+	// Unknown
+	if _, _, err := Resolve(rigs, "Slit"); !errors.Is(err, ErrPolecatNotFound) {
+		t.Errorf("Resolve(Slit) err = %v, want ErrPolecatNotFound", err)
+	}
+	if _, _, err := Resolve(rigs, "charlie/Toast"); err == nil {
+		t.Error("expected error for unknown rig")
+	}
+}
+
+func TestResolveNestedRig(t *testing.T) {
+	root := t.TempDir()
+	outer := &rig.Rig{Name: "outer", Path: filepath.Join(root, "outer")}
+	inner := &rig.Rig{Name: "outer/inner", Path: filepath.Join(root, "outer", "inner")}
+	rigs := []*rig.Rig{outer, inner}
+
+	r, name, err := Resolve(rigs, "outer/inner/Toast")
+	if err != nil || r != inner || name != "Toast" {
+		t.Errorf("Resolve(outer/inner/Toast) = %v, %q, %v; want outer/inner, Toast", r, name, err)
+	}
+	r, name, err = Resolve(rigs, "outer/Toast")
+	if err != nil || r != outer || name != "Toast" {
+		t.Errorf("Resolve(outer/Toast) = %v, %q, %v; want outer, Toast", r, name, err)
+	}
+	if _, _, err := Resolve(rigs, "outer/inner/"); err == nil {
+		t.Error("expected error for a rig address without a polecat name")
+	}
+}
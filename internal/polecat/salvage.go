@@ -0,0 +1,70 @@
+package polecat
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/git"
+)
+
+// SalvageDir returns the directory where salvage patches for a rig's
+// polecats are saved: <town>/mayor/salvage/<rig>/. The town root is passed
+// in rather than derived from the rig's path, which is wrong for nested rigs.
+func SalvageDir(townRoot, rigName string) string {
+	return filepath.Join(townRoot, "mayor", "salvage", rigName)
+}
+
+// Salvage saves the polecat's uncommitted work (including untracked files)
+// as a patch under SalvageDir so the worktree can be removed without losing
+// it. Each salvage gets its own file, <name>-<UTC time>.patch, so a pooled
+// name that is reused never overwrites an earlier salvage. Returns the
+// patch path, or "" if the worktree was clean.
+// Apply later with: git apply <patch>
+func (m *Manager) Salvage(townRoot, name string) (string, error) {
+	if !m.exists(name) {
+		return "", ErrPolecatNotFound
+	}
+
+	patch, err := git.NewGit(m.clonePath(name)).UncommittedPatch()
+	if err != nil {
+		return "", fmt.Errorf("capturing uncommitted work: %w", err)
+	}
+	if patch == "" {
+		return "", nil
+	}
+	return writeSalvagePatch(SalvageDir(townRoot, m.rig.Name), name, []byte(patch), time.Now())
+}
+
+// writeSalvagePatch writes patch to a new file in dir named for the polecat
+// and the time. The file is created exclusively; if the name is taken, a
+// numeric suffix is added rather than replacing the existing patch.
+func writeSalvagePatch(dir, name string, patch []byte, now time.Time) (string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("creating salvage dir: %w", err)
+	}
+	base := name + "-" + now.UTC().Format("20060102T150405Z")
+	for i := 1; ; i++ {
+		path := filepath.Join(dir, base+".patch")
+		if i > 1 {
+			path = filepath.Join(dir, fmt.Sprintf("%s-%d.patch", base, i))
+		}
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644) //nolint:gosec // G304: path is constructed internally
+		if errors.Is(err, os.ErrExist) {
+			continue
+		}
+		if err != nil {
+			return "", fmt.Errorf("writing salvage patch: %w", err)
+		}
+		if _, err := f.Write(patch); err != nil {
+			f.Close()
+			return "", fmt.Errorf("writing salvage patch: %w", err)
+		}
+		if err := f.Close(); err != nil {
+			return "", fmt.Errorf("writing salvage patch: %w", err)
+		}
+		return path, nil
+	}
+}
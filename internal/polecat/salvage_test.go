@@ -0,0 +1,47 @@
+package polecat
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSalvageDir(t *testing.T) {
+	got := SalvageDir("/town", "inner")
+	if want := filepath.Join("/town", "mayor", "salvage", "inner"); got != want {
+		t.Errorf("SalvageDir = %s, want %s", got, want)
+	}
+}
+
+func TestWriteSalvagePatchKeepsEarlierSalvage(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "salvage", "gastown")
+	now := time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+
+	first, err := writeSalvagePatch(dir, "Toast", []byte("first"), now)
+	if err != nil {
+		t.Fatalf("first salvage: %v", err)
+	}
+	if want := filepath.Join(dir, "Toast-20260304T050607Z.patch"); first != want {
+		t.Errorf("first path = %s, want %s", first, want)
+	}
+
+	// A reused pooled name salvaged in the same second must not overwrite
+	second, err := writeSalvagePatch(dir, "Toast", []byte("second"), now)
+	if err != nil {
+		t.Fatalf("second salvage: %v", err)
+	}
+	if second == first {
+		t.Fatalf("second salvage reused %s", first)
+	}
+
+	for path, want := range map[string]string{first: "first", second: "second"} {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != want {
+			t.Errorf("%s = %q, want %q", path, data, want)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package polecat
+
+// Polecats become cleanup candidates in one of two ways:
+//
+//   - Done: the polecat reported completion (State == StateDone). Its work is
+//     finished, so it is reaped regardless of session or worktree state. This
+//     is what 'gt cleanup' removes.
+//   - Stale: the polecat is not done but has been abandoned - no live session
+//     and either far behind the default branch or without an agent bead. It is
+//     never stale while it holds uncommitted work or is intentionally paused.
+//     This is what 'gt polecat stale' reports.
+//
+// The selectors below express both concepts as filters over the same polecat
+// list so commands can combine them instead of re-deriving them.
+
+// Selector reports whether a polecat should be selected.
+type Selector func(p *Polecat) bool
+
+// IsDone selects polecats in the done state (reapable by 'gt cleanup').
+func IsDone(p *Polecat) bool {
+	return p.State == StateDone
+}
+
+// Not inverts a selector.
+func Not(sel Selector) Selector {
+	return func(p *Polecat) bool { return !sel(p) }
+}
+
+// Select returns the polecats matched by every selector, preserving order.
+func Select(polecats []*Polecat, sels ...Selector) []*Polecat {
+	var out []*Polecat
+	for _, p := range polecats {
+		if matchesAll(p, sels) {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// SelectNames is like Select but returns only the polecat names.
+func SelectNames(polecats []*Polecat, sels ...Selector) []string {
+	var names []string
+	for _, p := range Select(polecats, sels...) {
+		names = append(names, p.Name)
+	}
+	return names
+}
+
+// IsStaleIn returns a selector for the polecats infos assess as stale (see
+// Manager.AssessStaleness).
+func IsStaleIn(infos []*StalenessInfo) Selector {
+	stale := make(map[string]bool, len(infos))
+	for _, info := range infos {
+		if info.IsStale {
+			stale[info.Name] = true
+		}
+	}
+	return func(p *Polecat) bool { return stale[p.Name] }
+}
+
+func matchesAll(p *Polecat, sels []Selector) bool {
+	for _, sel := range sels {
+		if !sel(p) {
+			return false
+		}
+	}
+	return true
+}
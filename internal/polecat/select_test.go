@@ -0,0 +1,63 @@
+package polecat
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSelectDone(t *testing.T) {
+	polecats := []*Polecat{
+		{Name: "Toast", State: StateDone},
+		{Name: "Nux", State: StateWorking},
+		{Name: "Furiosa", State: StateDone},
+		{Name: "Slit", State: StateStuck},
+	}
+
+	if got, want := SelectNames(polecats, IsDone), []string{"Toast", "Furiosa"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SelectNames(IsDone) = %v, want %v", got, want)
+	}
+	if got, want := SelectNames(polecats, Not(IsDone)), []string{"Nux", "Slit"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SelectNames(Not(IsDone)) = %v, want %v", got, want)
+	}
+
+	working := func(p *Polecat) bool { return p.State == StateWorking }
+	if got := SelectNames(polecats, IsDone, working); got != nil {
+		t.Errorf("SelectNames(IsDone, working) = %v, want none", got)
+	}
+	if got := len(Select(polecats)); got != len(polecats) {
+		t.Errorf("Select() with no selectors = %d polecats, want %d", got, len(polecats))
+	}
+}
+
+func TestIsStaleIn(t *testing.T) {
+	polecats := []*Polecat{
+		{Name: "Toast", State: StateWorking},
+		{Name: "Nux", State: StateWorking},
+		{Name: "Furiosa", State: StateStuck},
+		{Name: "Slit", State: StateDone},
+	}
+	infos := []*StalenessInfo{
+		{Name: "Toast", IsStale: true},
+		{Name: "Nux"},
+		{Name: "Furiosa", IsStale: true},
+		{Name: "Slit", IsStale: true},
+	}
+	got := SelectNames(polecats, Not(IsDone), IsStaleIn(infos))
+	if want := []string{"Toast", "Furiosa"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SelectNames(Not(IsDone), IsStaleIn) = %v, want %v", got, want)
+	}
+}
+
+func TestAssessStalenessDoneIsNotStale(t *testing.T) {
+	// A done polecat with no session and no bead would otherwise be stale;
+	// it belongs to gt cleanup instead.
+	info := &StalenessInfo{Name: "Toast", State: StateDone, CommitsBehind: 100}
+	if stale, reason := assessStaleness(info, 20); stale {
+		t.Errorf("done polecat assessed stale (%s)", reason)
+	}
+
+	info.State = StateWorking
+	if stale, _ := assessStaleness(info, 20); !stale {
+		t.Error("abandoned working polecat not assessed stale")
+	}
+}
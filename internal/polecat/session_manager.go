@@ -10,7 +10,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/rig"
@@ -28,14 +27,19 @@ func debugSession(context string, err error) {
 
 // Session errors
 var (
-	ErrSessionRunning  = errors.New("session already running")
-	ErrSessionNotFound = errors.New("session not found")
+	ErrSessionRunning   = errors.New("session already running")
+	ErrSessionNotFound  = errors.New("session not found")
+	ErrSessionAmbiguous = errors.New("session name collides with another rig")
 )
 
 // SessionManager handles polecat session lifecycle.
 type SessionManager struct {
 	tmux *tmux.Tmux
 	rig  *rig.Rig
+
+	// siblings maps the town's other rigs to their paths, for detecting
+	// session names that collide across rigs. Loaded on first use.
+	siblings map[string]string
 }
 
 // NewSessionManager creates a new polecat session manager for a rig.
@@ -94,7 +98,7 @@ type SessionInfo struct {
 
 // SessionName generates the tmux session name for a polecat.
 func (m *SessionManager) SessionName(polecat string) string {
-	return fmt.Sprintf("gt-%s-%s", m.rig.Name, polecat)
+	return SessionName(m.rig.Name, polecat)
 }
 
 // polecatDir returns the parent directory for a polecat.
@@ -143,7 +147,10 @@ func (m *SessionManager) Start(polecat string, opts SessionStartOptions) error {
 		return fmt.Errorf("%w: %s", ErrPolecatNotFound, polecat)
 	}
 
-	sessionID := m.SessionName(polecat)
+	sessionID, err := m.sessionFor(polecat)
+	if err != nil {
+		return err
+	}
 
 	// Check if session already exists
 	running, err := m.tmux.HasSession(sessionID)
@@ -193,7 +200,7 @@ func (m *SessionManager) Start(polecat string, opts SessionStartOptions) error {
 		Rig:              m.rig.Name,
 		AgentName:        polecat,
 		TownRoot:         townRoot,
-		BeadsDir:         beads.ResolveBeadsDir(m.rig.Path),
+		BeadsDir:         m.rig.BeadsDir(),
 		RuntimeConfigDir: opts.RuntimeConfigDir,
 		BeadsNoDaemon:    true,
 	})
@@ -245,7 +252,10 @@ func (m *SessionManager) Start(polecat string, opts SessionStartOptions) error {
 
 // Stop terminates a polecat session.
 func (m *SessionManager) Stop(polecat string, force bool) error {
-	sessionID := m.SessionName(polecat)
+	sessionID, err := m.sessionFor(polecat)
+	if err != nil {
+		return err
+	}
 
 	running, err := m.tmux.HasSession(sessionID)
 	if err != nil {
@@ -285,13 +295,19 @@ func (m *SessionManager) syncBeads(workDir string) error {
 
 // IsRunning checks if a polecat session is active.
 func (m *SessionManager) IsRunning(polecat string) (bool, error) {
-	sessionID := m.SessionName(polecat)
+	sessionID, err := m.sessionFor(polecat)
+	if err != nil {
+		return false, err
+	}
 	return m.tmux.HasSession(sessionID)
 }
 
 // Status returns detailed status for a polecat session.
 func (m *SessionManager) Status(polecat string) (*SessionInfo, error) {
-	sessionID := m.SessionName(polecat)
+	sessionID, err := m.sessionFor(polecat)
+	if err != nil {
+		return nil, err
+	}
 
 	running, err := m.tmux.HasSession(sessionID)
 	if err != nil {
@@ -349,13 +365,17 @@ func (m *SessionManager) List() ([]SessionInfo, error) {
 		return nil, err
 	}
 
-	prefix := fmt.Sprintf("gt-%s-", m.rig.Name)
+	prefix := SessionName(m.rig.Name, "")
 	var infos []SessionInfo
 
 	for _, sessionID := range sessions {
 		if !strings.HasPrefix(sessionID, prefix) {
 			continue
 		}
+		// gt-<rig>-b-c may be another rig's (gt-<rig>-b's) agent
+		if m.claimedElsewhere(sessionID) != "" {
+			continue
+		}
 
 		polecat := strings.TrimPrefix(sessionID, prefix)
 		infos = append(infos, SessionInfo{
@@ -371,7 +391,10 @@ func (m *SessionManager) List() ([]SessionInfo, error) {
 
 // Attach attaches to a polecat session.
 func (m *SessionManager) Attach(polecat string) error {
-	sessionID := m.SessionName(polecat)
+	sessionID, err := m.sessionFor(polecat)
+	if err != nil {
+		return err
+	}
 
 	running, err := m.tmux.HasSession(sessionID)
 	if err != nil {
@@ -386,7 +409,10 @@ func (m *SessionManager) Attach(polecat string) error {
 
 // Capture returns the recent output from a polecat session.
 func (m *SessionManager) Capture(polecat string, lines int) (string, error) {
-	sessionID := m.SessionName(polecat)
+	sessionID, err := m.sessionFor(polecat)
+	if err != nil {
+		return "", err
+	}
 
 	running, err := m.tmux.HasSession(sessionID)
 	if err != nil {
@@ -414,7 +440,10 @@ func (m *SessionManager) CaptureSession(sessionID string, lines int) (string, er
 
 // Inject sends a message to a polecat session.
 func (m *SessionManager) Inject(polecat, message string) error {
-	sessionID := m.SessionName(polecat)
+	sessionID, err := m.sessionFor(polecat)
+	if err != nil {
+		return err
+	}
 
 	running, err := m.tmux.HasSession(sessionID)
 	if err != nil {
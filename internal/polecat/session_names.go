@@ -0,0 +1,150 @@
+package polecat
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// SessionName returns the tmux session name for polecat name in rigName:
+// "gt-<rig>-<name>". The rig is part of the name, but since both rig and
+// polecat names may contain hyphens in older towns, two agents can still map
+// to the same session (rig "a" polecat "b-c" and rig "a-b" polecat "c").
+// SessionManager refuses to act on such names; see FindSessionCollisions.
+func SessionName(rigName, name string) string {
+	return fmt.Sprintf("gt-%s-%s", rigName, name)
+}
+
+// SessionCollision is a tmux session name that more than one rig agent maps
+// to. Whichever agent's session is running, operations on the others would
+// act on it instead.
+type SessionCollision struct {
+	Session string   `json:"session"`
+	Agents  []string `json:"agents"`  // "<rig>/<suffix>" of each agent mapping to Session
+	Running bool     `json:"running"` // The session currently exists
+}
+
+// FindSessionCollisions returns the session names claimed by more than one
+// agent, sorted by session name. agents maps each rig name to the session
+// suffixes of its agents (see RigSessionAgents); running lists live tmux
+// sessions.
+func FindSessionCollisions(agents map[string][]string, running []string) []SessionCollision {
+	owners := make(map[string][]string)
+	for rigName, suffixes := range agents {
+		for _, suffix := range suffixes {
+			name := SessionName(rigName, suffix)
+			owners[name] = append(owners[name], rigName+"/"+suffix)
+		}
+	}
+
+	live := make(map[string]bool, len(running))
+	for _, s := range running {
+		live[s] = true
+	}
+
+	var collisions []SessionCollision
+	for name, who := range owners {
+		if len(who) < 2 {
+			continue
+		}
+		sort.Strings(who)
+		collisions = append(collisions, SessionCollision{Session: name, Agents: who, Running: live[name]})
+	}
+	sort.Slice(collisions, func(i, j int) bool { return collisions[i].Session < collisions[j].Session })
+	return collisions
+}
+
+// RigSessionAgents returns the session suffixes of the agents that may run
+// under gt-<rig>-: its witness and refinery, crew members ("crew-<name>"),
+// and polecats.
+func RigSessionAgents(rigPath string) []string {
+	suffixes := []string{"witness", "refinery"}
+	for _, entry := range visibleDirs(filepath.Join(rigPath, "crew")) {
+		suffixes = append(suffixes, "crew-"+entry)
+	}
+	return append(suffixes, visibleDirs(filepath.Join(rigPath, "polecats"))...)
+}
+
+// visibleDirs lists the non-hidden subdirectories of dir.
+func visibleDirs(dir string) []string {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() && !strings.HasPrefix(entry.Name(), ".") {
+			names = append(names, entry.Name())
+		}
+	}
+	return names
+}
+
+// rigHasSessionAgent reports whether the rig at rigPath has an agent whose
+// session suffix is suffix.
+func rigHasSessionAgent(rigPath, suffix string) bool {
+	if isRigSingletonSession(suffix) {
+		return true
+	}
+	info, err := os.Stat(filepath.Join(rigPath, "polecats", suffix))
+	return err == nil && info.IsDir()
+}
+
+// otherRigs returns the paths of the town's other rigs by name, loaded from
+// mayor/rigs.json on first use. A rig outside a town has none.
+func (m *SessionManager) otherRigs() map[string]string {
+	if m.siblings != nil {
+		return m.siblings
+	}
+	m.siblings = make(map[string]string)
+	if m.rig.Path == "" {
+		return m.siblings
+	}
+	townRoot, err := workspace.Find(m.rig.Path)
+	if err != nil || townRoot == "" {
+		return m.siblings
+	}
+	rigsConfig, err := config.LoadRigsConfig(filepath.Join(townRoot, "mayor", "rigs.json"))
+	if err != nil {
+		return m.siblings
+	}
+	for name := range rigsConfig.Rigs {
+		if name != m.rig.Name {
+			m.siblings[name] = filepath.Join(townRoot, name)
+		}
+	}
+	return m.siblings
+}
+
+// claimedElsewhere returns the name of another rig with an agent whose
+// session is also named sessionID, or "" if no other rig maps to it.
+func (m *SessionManager) claimedElsewhere(sessionID string) string {
+	names := make([]string, 0, len(m.otherRigs()))
+	for name := range m.otherRigs() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		suffix, ok := strings.CutPrefix(sessionID, "gt-"+name+"-")
+		if ok && suffix != "" && rigHasSessionAgent(m.otherRigs()[name], suffix) {
+			return name
+		}
+	}
+	return ""
+}
+
+// sessionFor returns polecat's session name, or ErrSessionAmbiguous if
+// another rig has an agent with the same session name.
+func (m *SessionManager) sessionFor(polecat string) (string, error) {
+	sessionID := m.SessionName(polecat)
+	if other := m.claimedElsewhere(sessionID); other != "" {
+		return "", fmt.Errorf("%w: %s is also the session of an agent in rig %s (run 'gt doctor')",
+			ErrSessionAmbiguous, sessionID, other)
+	}
+	return sessionID, nil
+}
@@ -0,0 +1,57 @@
+package polecat
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+func TestFindSessionCollisions(t *testing.T) {
+	agents := map[string][]string{
+		"a":   {"witness", "refinery", "b-c", "b-witness", "Toast"},
+		"a-b": {"witness", "refinery", "c"},
+	}
+	got := FindSessionCollisions(agents, []string{"gt-a-b-witness", "gt-a-Toast"})
+	if len(got) != 2 {
+		t.Fatalf("collisions = %+v, want 2", got)
+	}
+	if got[0].Session != "gt-a-b-c" || got[0].Running || len(got[0].Agents) != 2 ||
+		got[0].Agents[0] != "a-b/c" || got[0].Agents[1] != "a/b-c" {
+		t.Errorf("collisions[0] = %+v, want gt-a-b-c shared by a-b/c and a/b-c", got[0])
+	}
+	if got[1].Session != "gt-a-b-witness" || !got[1].Running {
+		t.Errorf("collisions[1] = %+v, want running gt-a-b-witness", got[1])
+	}
+}
+
+func TestSessionManagerRefusesCollidingSession(t *testing.T) {
+	townRoot := t.TempDir()
+	for _, dir := range []string{"a/polecats/b-c", "a/polecats/b-d", "a-b/polecats/c"} {
+		if err := os.MkdirAll(filepath.Join(townRoot, dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	m := NewSessionManager(tmux.NewTmux(), &rig.Rig{Name: "a", Path: filepath.Join(townRoot, "a")})
+	m.siblings = map[string]string{"a-b": filepath.Join(townRoot, "a-b")}
+
+	if _, err := m.IsRunning("b-c"); !errors.Is(err, ErrSessionAmbiguous) {
+		t.Errorf("IsRunning(b-c) = %v, want ErrSessionAmbiguous", err)
+	}
+	if err := m.Stop("b-c", true); !errors.Is(err, ErrSessionAmbiguous) {
+		t.Errorf("Stop(b-c) = %v, want ErrSessionAmbiguous", err)
+	}
+	if err := m.Start("b-c", SessionStartOptions{}); !errors.Is(err, ErrSessionAmbiguous) {
+		t.Errorf("Start(b-c) = %v, want ErrSessionAmbiguous", err)
+	}
+	// a-b has no polecat d, so gt-a-b-d is unambiguously a/b-d
+	if got := m.claimedElsewhere(m.SessionName("b-d")); got != "" {
+		t.Errorf("claimedElsewhere(gt-a-b-d) = %q, want none", got)
+	}
+	if got := m.claimedElsewhere("gt-a-b-witness"); got != "a-b" {
+		t.Errorf("claimedElsewhere(gt-a-b-witness) = %q, want a-b", got)
+	}
+}
@@ -1,7 +1,12 @@
 // Package polecat provides polecat lifecycle management.
 package polecat
 
-import "time"
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
 
 // State represents the current state of a polecat.
 // In the transient model, polecats exist only while working.
@@ -24,6 +29,65 @@ const (
 	StateActive State = "active"
 )
 
+// knownStates lists every valid State, in lifecycle order.
+var knownStates = []State{StateWorking, StateDone, StateStuck, StateActive}
+
+// ParseState parses a state name, ignoring case and surrounding whitespace.
+// Unknown names are rejected so config and stored metadata can't carry
+// garbage states.
+func ParseState(s string) (State, error) {
+	name := State(strings.ToLower(strings.TrimSpace(s)))
+	for _, known := range knownStates {
+		if name == known {
+			return known, nil
+		}
+	}
+	names := make([]string, len(knownStates))
+	for i, known := range knownStates {
+		names[i] = string(known)
+	}
+	return "", fmt.Errorf("unknown polecat state %q (want one of: %s)", s, strings.Join(names, ", "))
+}
+
+// String returns the state name.
+func (s State) String() string {
+	return string(s)
+}
+
+// Valid reports whether s is a known state.
+func (s State) Valid() bool {
+	_, err := ParseState(string(s))
+	return err == nil
+}
+
+// MarshalJSON encodes the state as its name. The zero State (unknown) encodes
+// as ""; any other unrecognized value is an error.
+func (s State) MarshalJSON() ([]byte, error) {
+	if s != "" && !s.Valid() {
+		return nil, fmt.Errorf("unknown polecat state %q", string(s))
+	}
+	return json.Marshal(string(s))
+}
+
+// UnmarshalJSON decodes a state name, rejecting unknown states. "" decodes
+// to the zero State.
+func (s *State) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return fmt.Errorf("polecat state must be a string: %w", err)
+	}
+	if name == "" {
+		*s = ""
+		return nil
+	}
+	parsed, err := ParseState(name)
+	if err != nil {
+		return err
+	}
+	*s = parsed
+	return nil
+}
+
 // IsWorking returns true if the polecat is currently working.
 func (s State) IsWorking() bool {
 	return s == StateWorking
@@ -0,0 +1,57 @@
+package polecat
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseState(t *testing.T) {
+	for in, want := range map[string]State{
+		"done":     StateDone,
+		"Working":  StateWorking,
+		" stuck\n": StateStuck,
+		"active":   StateActive,
+	} {
+		got, err := ParseState(in)
+		if err != nil || got != want {
+			t.Errorf("ParseState(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+
+	for _, bad := range []string{"", "finished", "don"} {
+		if _, err := ParseState(bad); err == nil {
+			t.Errorf("ParseState(%q) accepted an unknown state", bad)
+		}
+	}
+}
+
+func TestStateJSON(t *testing.T) {
+	data, err := json.Marshal(Polecat{Name: "Toast", State: StateDone})
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	var p Polecat
+	if err := json.Unmarshal(data, &p); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if p.State != StateDone || p.State.String() != "done" {
+		t.Errorf("round-tripped state = %q, want done", p.State)
+	}
+
+	// Unknown states are rejected in both directions
+	if err := json.Unmarshal([]byte(`{"state":"zombie"}`), &p); err == nil {
+		t.Error("Unmarshal accepted an unknown state")
+	}
+	if _, err := json.Marshal(State("zombie")); err == nil {
+		t.Error("Marshal accepted an unknown state")
+	}
+
+	// The zero State (unknown) round-trips as ""
+	var s State
+	if err := json.Unmarshal([]byte(`""`), &s); err != nil || s != "" {
+		t.Errorf("Unmarshal(\"\") = %q, %v", s, err)
+	}
+	if data, err := json.Marshal(State("")); err != nil || string(data) != `""` {
+		t.Errorf("Marshal(\"\") = %s, %v", data, err)
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/runtime"
 	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
 	"github.com/steveyegge/gastown/internal/util"
 )
@@ -306,7 +307,7 @@ func (m *Manager) Queue() ([]QueueItem, error) {
 		items = append(items, QueueItem{
 			Position: 0, // 0 = currently processing
 			MR:       ref.CurrentMR,
-			Age:      formatAge(ref.CurrentMR.CreatedAt),
+			Age:      style.HumanizeTime(ref.CurrentMR.CreatedAt),
 		})
 	}
 
@@ -337,7 +338,7 @@ func (m *Manager) Queue() ([]QueueItem, error) {
 			items = append(items, QueueItem{
 				Position: pos,
 				MR:       mr,
-				Age:      formatAge(mr.CreatedAt),
+				Age:      style.HumanizeTime(mr.CreatedAt),
 			})
 			pos++
 		}
@@ -566,22 +567,6 @@ func (m *Manager) pushWithRetry(targetBranch string, config MergeConfig) error {
 	return fmt.Errorf("push failed after %d retries: %v", config.PushRetryCount, lastErr)
 }
 
-// formatAge formats a duration since the given time.
-func formatAge(t time.Time) string {
-	d := time.Since(t)
-
-	if d < time.Minute {
-		return fmt.Sprintf("%ds ago", int(d.Seconds()))
-	}
-	if d < time.Hour {
-		return fmt.Sprintf("%dm ago", int(d.Minutes()))
-	}
-	if d < 24*time.Hour {
-		return fmt.Sprintf("%dh ago", int(d.Hours()))
-	}
-	return fmt.Sprintf("%dd ago", int(d.Hours()/24))
-}
-
 // notifyWorkerConflict sends a conflict notification to a polecat.
 func (m *Manager) notifyWorkerConflict(mr *MergeRequest) {
 	router := mail.NewRouter(m.workDir)
@@ -175,7 +175,7 @@ func (r *Rig) getBeadLabel(key string) interface{} {
 	rigBeadID := beads.RigBeadIDWithPrefix(prefix, r.Name)
 
 	// Load the bead
-	beadsDir := beads.ResolveBeadsDir(r.Path)
+	beadsDir := r.BeadsDir()
 	bd := beads.NewWithBeadsDir(townRoot, beadsDir)
 
 	issue, err := bd.Show(rigBeadID)
@@ -8,6 +8,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -63,6 +64,7 @@ func NewManager(townRoot string, rigsConfig *config.RigsConfig, g *git.Git) *Man
 
 // DiscoverRigs returns all rigs registered in the workspace.
 // Rigs that fail to load are logged to stderr and skipped; partial results are returned.
+// Rigs are ordered by priority (see SortByPriority).
 func (m *Manager) DiscoverRigs() ([]*Rig, error) {
 	var rigs []*Rig
 
@@ -75,9 +77,74 @@ func (m *Manager) DiscoverRigs() ([]*Rig, error) {
 		rigs = append(rigs, rig)
 	}
 
+	SortByPriority(rigs)
 	return rigs, nil
 }
 
+// NestedRig is a rig whose directory lies inside another rig's directory.
+type NestedRig struct {
+	Rig    *Rig
+	Parent *Rig // Outermost rig containing Rig
+}
+
+// DiscoverRootRigs is like DiscoverRigs but drops rigs nested inside another
+// rig's directory, so overlapping worktrees are attributed only to the
+// outermost rig. The dropped rigs are returned separately for reporting.
+func (m *Manager) DiscoverRootRigs() ([]*Rig, []NestedRig, error) {
+	rigs, err := m.DiscoverRigs()
+	if err != nil {
+		return nil, nil, err
+	}
+	roots, nested := SplitNestedRigs(rigs)
+	return roots, nested, nil
+}
+
+// SplitNestedRigs separates rigs whose path lies inside another rig's path
+// from the outermost (root) rigs. The order of rigs is preserved in roots.
+func SplitNestedRigs(rigs []*Rig) ([]*Rig, []NestedRig) {
+	var roots []*Rig
+	var nested []NestedRig
+	for _, r := range rigs {
+		if parent := outermostContainingRig(r, rigs); parent != nil {
+			nested = append(nested, NestedRig{Rig: r, Parent: parent})
+			continue
+		}
+		roots = append(roots, r)
+	}
+	return roots, nested
+}
+
+// outermostContainingRig returns the rig with the shortest path that strictly
+// contains r's path, or nil if r is not nested.
+func outermostContainingRig(r *Rig, rigs []*Rig) *Rig {
+	path := filepath.Clean(r.Path)
+	var outer *Rig
+	for _, other := range rigs {
+		if other == r {
+			continue
+		}
+		otherPath := filepath.Clean(other.Path)
+		if !strings.HasPrefix(path, otherPath+string(filepath.Separator)) {
+			continue
+		}
+		if outer == nil || len(otherPath) < len(filepath.Clean(outer.Path)) {
+			outer = other
+		}
+	}
+	return outer
+}
+
+// SortByPriority orders rigs by descending Priority, then by name, so the
+// most important rigs are processed and reported first.
+func SortByPriority(rigs []*Rig) {
+	sort.SliceStable(rigs, func(i, j int) bool {
+		if rigs[i].Priority != rigs[j].Priority {
+			return rigs[i].Priority > rigs[j].Priority
+		}
+		return rigs[i].Name < rigs[j].Name
+	})
+}
+
 // GetRig returns a specific rig by name.
 func (m *Manager) GetRig(name string) (*Rig, error) {
 	entry, ok := m.config.Rigs[name]
@@ -113,6 +180,9 @@ func (m *Manager) loadRig(name string, entry config.RigEntry) (*Rig, error) {
 		GitURL:    entry.GitURL,
 		LocalRepo: entry.LocalRepo,
 		Config:    entry.BeadsConfig,
+
+		DefaultBranchName: entry.DefaultBranch,
+		Priority:          entry.Priority,
 	}
 
 	// Scan for polecats
@@ -889,6 +959,20 @@ func (m *Manager) RemoveRig(name string) error {
 	return nil
 }
 
+// SetDefaultBranch records the default branch for a registered rig.
+// An empty branch clears the setting so the branch is auto-detected again.
+// Like RemoveRig, this only updates the in-memory registry; callers save it.
+func (m *Manager) SetDefaultBranch(name, branch string) error {
+	entry, ok := m.config.Rigs[name]
+	if !ok {
+		return ErrRigNotFound
+	}
+
+	entry.DefaultBranch = branch
+	m.config.Rigs[name] = entry
+	return nil
+}
+
 // ListRigNames returns the names of all registered rigs.
 func (m *Manager) ListRigNames() []string {
 	names := make([]string, 0, len(m.config.Rigs))
@@ -101,6 +101,31 @@ func TestDiscoverRigs(t *testing.T) {
 	}
 }
 
+func TestDiscoverRigsPriorityOrder(t *testing.T) {
+	t.Parallel()
+	root, rigsConfig := setupTestTown(t)
+
+	for name, priority := range map[string]int{"alpha": 0, "bravo": 10, "charlie": 0, "delta": 5} {
+		createTestRig(t, root, name)
+		rigsConfig.Rigs[name] = config.RigEntry{Priority: priority}
+	}
+
+	manager := NewManager(root, rigsConfig, git.NewGit(root))
+	rigs, err := manager.DiscoverRigs()
+	if err != nil {
+		t.Fatalf("DiscoverRigs: %v", err)
+	}
+
+	var names []string
+	for _, r := range rigs {
+		names = append(names, r.Name)
+	}
+	want := []string{"bravo", "delta", "alpha", "charlie"}
+	if !slices.Equal(names, want) {
+		t.Errorf("order = %v, want %v", names, want)
+	}
+}
+
 func TestGetRig(t *testing.T) {
 	t.Parallel()
 	root, rigsConfig := setupTestTown(t)
@@ -175,6 +200,58 @@ func TestRemoveRigNotFound(t *testing.T) {
 	}
 }
 
+func TestSetDefaultBranch(t *testing.T) {
+	t.Parallel()
+	root, rigsConfig := setupTestTown(t)
+
+	createTestRig(t, root, "gastown")
+	rigsConfig.Rigs["gastown"] = config.RigEntry{
+		GitURL: "git@github.com:test/gastown.git",
+	}
+
+	manager := NewManager(root, rigsConfig, git.NewGit(root))
+
+	if err := manager.SetDefaultBranch("gastown", "develop"); err != nil {
+		t.Fatalf("SetDefaultBranch: %v", err)
+	}
+	if got := rigsConfig.Rigs["gastown"].DefaultBranch; got != "develop" {
+		t.Errorf("entry DefaultBranch = %q, want develop", got)
+	}
+
+	r, err := manager.GetRig("gastown")
+	if err != nil {
+		t.Fatalf("GetRig: %v", err)
+	}
+	if r.DefaultBranchName != "develop" {
+		t.Errorf("DefaultBranchName = %q, want develop", r.DefaultBranchName)
+	}
+	if got := r.DefaultBranch(); got != "develop" {
+		t.Errorf("DefaultBranch() = %q, want develop", got)
+	}
+
+	// Clearing falls back to config.json / "main"
+	if err := manager.SetDefaultBranch("gastown", ""); err != nil {
+		t.Fatalf("SetDefaultBranch clear: %v", err)
+	}
+	r, err = manager.GetRig("gastown")
+	if err != nil {
+		t.Fatalf("GetRig: %v", err)
+	}
+	if got := r.DefaultBranch(); got != "main" {
+		t.Errorf("DefaultBranch() after clear = %q, want main", got)
+	}
+}
+
+func TestSetDefaultBranchNotFound(t *testing.T) {
+	t.Parallel()
+	root, rigsConfig := setupTestTown(t)
+	manager := NewManager(root, rigsConfig, git.NewGit(root))
+
+	if err := manager.SetDefaultBranch("nonexistent", "main"); err != ErrRigNotFound {
+		t.Errorf("SetDefaultBranch = %v, want ErrRigNotFound", err)
+	}
+}
+
 func TestAddRig_RejectsInvalidNames(t *testing.T) {
 	t.Parallel()
 	root, rigsConfig := setupTestTown(t)
@@ -246,6 +323,31 @@ func TestRigSummary(t *testing.T) {
 	}
 }
 
+func TestRigBeadsDir(t *testing.T) {
+	t.Parallel()
+	root := t.TempDir()
+	r := &Rig{Name: "test", Path: root}
+
+	// Local beads: the rig's own .beads
+	if got, want := r.BeadsDir(), filepath.Join(root, ".beads"); got != want {
+		t.Errorf("BeadsDir() = %q, want %q", got, want)
+	}
+
+	// Tracked beads: follow the redirect into mayor/rig
+	if err := os.MkdirAll(filepath.Join(root, ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(root, "mayor", "rig", ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, ".beads", "redirect"), []byte("mayor/rig/.beads\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := r.BeadsDir(), filepath.Join(root, "mayor", "rig", ".beads"); got != want {
+		t.Errorf("BeadsDir() with redirect = %q, want %q", got, want)
+	}
+}
+
 func TestEnsureGitignoreEntry_AddsEntry(t *testing.T) {
 	t.Parallel()
 	root, rigsConfig := setupTestTown(t)
@@ -594,3 +696,26 @@ func TestSplitCompoundWord(t *testing.T) {
 		})
 	}
 }
+
+func TestSplitNestedRigs(t *testing.T) {
+	t.Parallel()
+	root := t.TempDir()
+	outer := &Rig{Name: "outer", Path: filepath.Join(root, "outer")}
+	inner := &Rig{Name: "outer/inner", Path: filepath.Join(root, "outer", "inner")}
+	deepest := &Rig{Name: "outer/inner/deep", Path: filepath.Join(root, "outer", "inner", "deep")}
+	sibling := &Rig{Name: "outer-two", Path: filepath.Join(root, "outer-two")}
+
+	roots, nested := SplitNestedRigs([]*Rig{deepest, outer, sibling, inner})
+
+	if len(roots) != 2 || roots[0] != outer || roots[1] != sibling {
+		t.Errorf("roots = %v, want [outer outer-two]", roots)
+	}
+	if len(nested) != 2 {
+		t.Fatalf("nested = %d, want 2", len(nested))
+	}
+	for _, n := range nested {
+		if n.Parent != outer {
+			t.Errorf("%s parent = %s, want outermost rig outer", n.Rig.Name, n.Parent.Name)
+		}
+	}
+}
@@ -2,7 +2,12 @@
 package rig
 
 import (
+	"os"
+	"path/filepath"
+
+	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/git"
 )
 
 // Rig represents a managed repository in the workspace.
@@ -37,6 +42,14 @@ type Rig struct {
 
 	// HasMayor indicates if the rig has a mayor clone.
 	HasMayor bool `json:"has_mayor"`
+
+	// DefaultBranchName is the default branch set in the rig registry
+	// (rigs.json), if any. Use DefaultBranch() for the resolved value.
+	DefaultBranchName string `json:"default_branch,omitempty"`
+
+	// Priority orders rigs in discovery (higher first, ties by name).
+	// Set via the rig registry (rigs.json).
+	Priority int `json:"priority,omitempty"`
 }
 
 // AgentDirs are the standard agent directories in a rig.
@@ -80,12 +93,45 @@ func (r *Rig) BeadsPath() string {
 	return r.Path
 }
 
+// BeadsDir returns the .beads directory holding this rig's beads database.
+// Follows .beads/redirect, so rigs with tracked beads resolve to the
+// mayor/rig clone's .beads. bd commands targeting the rig's beads should run
+// with BEADS_DIR set to this path rather than assuming the rig root.
+func (r *Rig) BeadsDir() string {
+	return beads.ResolveBeadsDir(r.Path)
+}
+
 // DefaultBranch returns the configured default branch for this rig.
+// The registry setting (gt rig default-branch) takes precedence over config.json.
 // Falls back to "main" if not configured or if config cannot be loaded.
 func (r *Rig) DefaultBranch() string {
+	if branch, _ := r.ConfiguredDefaultBranch(); branch != "" {
+		return branch
+	}
+	return "main"
+}
+
+// ConfiguredDefaultBranch returns the default branch set for this rig and
+// the file that sets it: the registry (gt rig default-branch, in
+// mayor/rigs.json) over the rig's config.json. Returns "" if neither does.
+func (r *Rig) ConfiguredDefaultBranch() (branch, source string) {
+	if r.DefaultBranchName != "" {
+		return r.DefaultBranchName, "mayor/rigs.json"
+	}
 	cfg, err := LoadRigConfig(r.Path)
 	if err != nil || cfg.DefaultBranch == "" {
-		return "main"
+		return "", ""
+	}
+	return cfg.DefaultBranch, "config.json"
+}
+
+// DetectDefaultBranch auto-detects the rig's default branch from origin/HEAD
+// in the shared bare repo (or mayor/rig for legacy rigs), ignoring any
+// configured value. Returns "main" if detection fails.
+func (r *Rig) DetectDefaultBranch() string {
+	bareRepoPath := filepath.Join(r.Path, ".repo.git")
+	if info, err := os.Stat(bareRepoPath); err == nil && info.IsDir() {
+		return git.NewGitWithDir(bareRepoPath, "").RemoteDefaultBranch()
 	}
-	return cfg.DefaultBranch
+	return git.NewGit(filepath.Join(r.Path, "mayor", "rig")).RemoteDefaultBranch()
 }
@@ -0,0 +1,71 @@
+package style
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	day  = 24 * time.Hour
+	year = 365 * day
+)
+
+// HumanizeDuration formats a duration as a short, coarse age string using
+// its largest whole unit (e.g. "45s", "12m", "36h", "3d", "2y"). Hours are
+// used up to two days so that "36h" isn't rounded down to "1d".
+func HumanizeDuration(d time.Duration) string {
+	if d < 0 {
+		d = -d
+	}
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 2*day:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	case d < year:
+		return fmt.Sprintf("%dd", int(d/day))
+	default:
+		return fmt.Sprintf("%dy", int(d/year))
+	}
+}
+
+// HumanizeTime formats t relative to now in words, e.g. "just now",
+// "3 minutes ago", "2 days ago", or "in 5 minutes" for a future time. The
+// zero time is "unknown". Use it for timestamps in prose and listings;
+// HumanizeDuration suits aligned columns.
+func HumanizeTime(t time.Time) string {
+	return HumanizeTimeAt(t, time.Now())
+}
+
+// HumanizeTimeAt is HumanizeTime relative to now instead of the current time,
+// for callers that render several timestamps against one clock reading.
+func HumanizeTimeAt(t, now time.Time) string {
+	if t.IsZero() {
+		return "unknown"
+	}
+	d := now.Sub(t)
+	future := d < 0
+	if future {
+		d = -d
+	}
+
+	var span string
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		span = Count(int(d.Minutes()), "minute", "minutes")
+	case d < day:
+		span = Count(int(d.Hours()), "hour", "hours")
+	case d < year:
+		span = Count(int(d/day), "day", "days")
+	default:
+		span = Count(int(d/year), "year", "years")
+	}
+	if future {
+		return "in " + span
+	}
+	return span + " ago"
+}
@@ -0,0 +1,57 @@
+package style
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHumanizeDuration(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{0, "0s"},
+		{59 * time.Second, "59s"},
+		{time.Minute, "1m"},
+		{59*time.Minute + 59*time.Second, "59m"},
+		{36 * time.Hour, "36h"},
+		{48 * time.Hour, "2d"},
+		{364 * day, "364d"},
+		{year, "1y"},
+		{-3 * time.Minute, "3m"},
+	}
+	for _, tt := range tests {
+		if got := HumanizeDuration(tt.d); got != tt.want {
+			t.Errorf("HumanizeDuration(%v) = %q, want %q", tt.d, got, tt.want)
+		}
+	}
+}
+
+func TestHumanizeTime(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		ago  time.Duration
+		want string
+	}{
+		{0, "just now"},
+		{59 * time.Second, "just now"},
+		{time.Minute, "1 minute ago"},
+		{3 * time.Minute, "3 minutes ago"},
+		{time.Hour, "1 hour ago"},
+		{23*time.Hour + 59*time.Minute, "23 hours ago"},
+		{day, "1 day ago"},
+		{364 * day, "364 days ago"},
+		{year, "1 year ago"},
+		{3*year + 10*day, "3 years ago"},
+		{-5 * time.Minute, "in 5 minutes"},
+		{-30 * time.Second, "just now"},
+	}
+	for _, tt := range tests {
+		if got := HumanizeTimeAt(now.Add(-tt.ago), now); got != tt.want {
+			t.Errorf("HumanizeTimeAt(now - %v) = %q, want %q", tt.ago, got, tt.want)
+		}
+	}
+	if got := HumanizeTimeAt(time.Time{}, now); got != "unknown" {
+		t.Errorf("HumanizeTimeAt(zero) = %q, want unknown", got)
+	}
+}
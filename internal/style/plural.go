@@ -0,0 +1,13 @@
+package style
+
+import "fmt"
+
+// Count renders n with the singular or plural form of a noun, e.g.
+// Count(1, "polecat", "polecats") is "1 polecat" and Count(3, ...) is
+// "3 polecats". Zero takes the plural, as in English ("0 polecats").
+func Count(n int, singular, plural string) string {
+	if n == 1 || n == -1 {
+		return fmt.Sprintf("%d %s", n, singular)
+	}
+	return fmt.Sprintf("%d %s", n, plural)
+}
@@ -0,0 +1,20 @@
+package style
+
+import "testing"
+
+func TestCount(t *testing.T) {
+	tests := []struct {
+		n    int
+		want string
+	}{
+		{0, "0 branches"},
+		{1, "1 branch"},
+		{2, "2 branches"},
+		{100, "100 branches"},
+	}
+	for _, tt := range tests {
+		if got := Count(tt.n, "branch", "branches"); got != tt.want {
+			t.Errorf("Count(%d) = %q, want %q", tt.n, got, tt.want)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package tmux
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -23,16 +24,28 @@ var (
 )
 
 // Tmux wraps tmux operations.
-type Tmux struct{}
+type Tmux struct {
+	ctx context.Context // Optional: kills running commands when done (see WithContext)
+}
 
 // NewTmux creates a new Tmux wrapper.
 func NewTmux() *Tmux {
 	return &Tmux{}
 }
 
+// WithContext returns a copy of t whose commands are killed once ctx is done.
+func (t *Tmux) WithContext(ctx context.Context) *Tmux {
+	return &Tmux{ctx: ctx}
+}
+
 // run executes a tmux command and returns stdout.
 func (t *Tmux) run(args ...string) (string, error) {
-	cmd := exec.Command("tmux", args...)
+	var cmd *exec.Cmd
+	if t.ctx != nil {
+		cmd = exec.CommandContext(t.ctx, "tmux", args...)
+	} else {
+		cmd = exec.Command("tmux", args...)
+	}
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr